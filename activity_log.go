@@ -1,5 +1,11 @@
 package doppler
 
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
 type (
 	// ActivityLog represents a doppler activity log.
 	ActivityLog struct {
@@ -25,7 +31,7 @@ type (
 
 	// ActivityLogGetOptions represents the query parameters for an activity log get request.
 	ActivityLogGetOptions struct {
-		ID string `url:"log" json:"-"` // ID is the unique identifier for the log object.
+		ID string `url:"log" json:"-" validate:"required"` // ID is the unique identifier for the log object.
 	}
 
 	// ActivityLogListResponse represents a response from the activity log list endpoint.
@@ -43,4 +49,75 @@ type (
 	ActivityLogListOptions struct {
 		ListOptions `url:",inline" json:"-"`
 	}
+
+	// ActivityLogCursor identifies the last activity log a follower has seen. Persist it to resume following after a
+	// restart. The ID is preferred; the time is used if the log with the ID isn't returned anymore.
+	ActivityLogCursor struct {
+		ID        string     `json:"id,omitempty"`         // Unique identifier of the last seen activity log.
+		CreatedAt *time.Time `json:"created_at,omitempty"` // Creation time of the last seen activity log.
+	}
+
+	// ActivityLogFollowOptions represents the options for following the activity logs.
+	ActivityLogFollowOptions struct {
+		Cursor     *ActivityLogCursor // Resume after the given activity log; nil to only follow logs created from now on.
+		Interval   time.Duration      // Time between two polls. Defaults to 10 seconds.
+		PerPage    int                // Number of activity logs fetched per request. Defaults to 20.
+		MaxBackoff time.Duration      // Maximum time to wait after failed polls. Defaults to 5 minutes.
+		OnError    func(err error)    // Called for every failed poll; following continues after backing off.
+	}
+
+	// ActivityLogQuery represents a client-side filter on activity logs. Empty fields match all logs.
+	ActivityLogQuery struct {
+		Project     string         // Identifier of the project.
+		Environment string         // Identifier of the environment.
+		Config      string         // Name of the config.
+		UserEmail   string         // Email address of the user that triggered the event; compared case-insensitively.
+		Since       time.Time      // Only match logs created at or after this time.
+		Until       time.Time      // Only match logs created before this time.
+		Text        *regexp.Regexp // Only match logs whose text matches this expression.
+	}
 )
+
+// Cursor returns the cursor pointing at the activity log.
+func (l *ActivityLog) Cursor() ActivityLogCursor {
+	var cursor ActivityLogCursor
+	if l.ID != nil {
+		cursor.ID = *l.ID
+	}
+	if l.CreatedAt != nil {
+		if createdAt, err := time.Parse(time.RFC3339, *l.CreatedAt); err == nil {
+			cursor.CreatedAt = &createdAt
+		}
+	}
+
+	return cursor
+}
+
+// matchString reports whether the value matches the wanted one; an empty wanted value matches all values.
+func matchString(want string, value *string) bool {
+	return want == "" || (value != nil && *value == want)
+}
+
+// Match reports whether the activity log matches the query. Logs without a valid creation time don't match queries
+// with a time range.
+func (q *ActivityLogQuery) Match(l *ActivityLog) bool {
+	if !matchString(q.Project, l.Project) || !matchString(q.Environment, l.Environment) || !matchString(q.Config, l.Config) {
+		return false
+	}
+	if q.UserEmail != "" && (l.User == nil || l.User.Email == nil || !strings.EqualFold(q.UserEmail, *l.User.Email)) {
+		return false
+	}
+	if q.Text != nil && (l.Text == nil || !q.Text.MatchString(*l.Text)) {
+		return false
+	}
+	if q.Since.IsZero() && q.Until.IsZero() {
+		return true
+	}
+
+	createdAt := l.Cursor().CreatedAt
+	if createdAt == nil {
+		return false
+	}
+
+	return !createdAt.Before(q.Since) && (q.Until.IsZero() || createdAt.Before(q.Until))
+}
@@ -65,3 +65,22 @@ func (c Client) List(ctx context.Context, opts *doppler.ActivityLogListOptions)
 func List(ctx context.Context, opts *doppler.ActivityLogListOptions) ([]*doppler.ActivityLog, doppler.APIResponse, error) {
 	return Default().List(ctx, opts)
 }
+
+// Iter returns an iterator over the activity logs, newest first. Pages are fetched on demand, starting at the page
+// given in opts.
+func (c Client) Iter(ctx context.Context, opts *doppler.ActivityLogListOptions) doppler.Iterator[*doppler.ActivityLog] {
+	var listOpts doppler.ListOptions
+	if opts != nil {
+		listOpts = opts.ListOptions
+	}
+
+	return doppler.NewPageIterator(ctx, listOpts, func(ctx context.Context, page doppler.ListOptions) ([]*doppler.ActivityLog, error) {
+		logs, _, err := c.fetchList(ctx, &doppler.ActivityLogListOptions{ListOptions: page})
+		return logs, err
+	})
+}
+
+// Iter returns an iterator over the activity logs using the default client.
+func Iter(ctx context.Context, opts *doppler.ActivityLogListOptions) doppler.Iterator[*doppler.ActivityLog] {
+	return Default().Iter(ctx, opts)
+}
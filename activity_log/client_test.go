@@ -3,6 +3,7 @@ package activitylog_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -303,3 +304,36 @@ func TestActivityLog_List(t *testing.T) {
 		})
 	}
 }
+
+func TestActivityLog_Iter(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(&followServer{
+		logs: []*doppler.ActivityLog{followLog("3", 3), followLog("2", 2), followLog("1", 1)},
+	})
+	defer ts.Close()
+
+	client := &activitylog.Client{
+		Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+			URL: pointer.To(ts.URL),
+		}),
+		Key: "test",
+	}
+
+	it := client.Iter(context.Background(), &doppler.ActivityLogListOptions{ListOptions: doppler.ListOptions{PerPage: 2}})
+	var gotIDs []string
+	for {
+		log, err := it.Next()
+		if errors.Is(err, doppler.ErrIteratorDone) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		gotIDs = append(gotIDs, *log.ID)
+	}
+
+	if diff := cmp.Diff([]string{"3", "2", "1"}, gotIDs); diff != "" {
+		t.Errorf("Unexpected activity logs (-want +got):\n%s", diff)
+	}
+}
@@ -15,5 +15,35 @@ Example:
 	for _, log := range activityLogs {
 		fmt.Printf("%+v\n", log)
 	}
+
+	// Find out who touched prd last week
+	it := activitylog.Query(ctx, &doppler.ActivityLogQuery{
+		Project: "backend",
+		Config:  "prd",
+		Since:   time.Now().AddDate(0, 0, -7),
+	})
+	for {
+		entry, err := it.Next()
+		if errors.Is(err, doppler.ErrIteratorDone) {
+			break
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(*entry.User.Email, *entry.Text)
+	}
+
+	// Follow new activity logs, resuming after the last one seen before a restart
+	logs, err := activitylog.Follow(ctx, &doppler.ActivityLogFollowOptions{
+		Cursor:  lastCursor,
+		OnError: func(err error) { log.Println(err) },
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	for entry := range logs {
+		fmt.Println(*entry.Text)
+		lastCursor = pointer.To(entry.Cursor())
+	}
 */
 package activitylog
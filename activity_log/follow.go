@@ -0,0 +1,222 @@
+package activitylog
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/nikoksr/doppler-go"
+)
+
+const (
+	// DefaultFollowInterval is the default time between two polls of Follow.
+	DefaultFollowInterval = 10 * time.Second
+
+	// DefaultFollowPerPage is the default number of activity logs fetched per request by Follow.
+	DefaultFollowPerPage = 20
+
+	// DefaultFollowMaxBackoff is the default maximum time Follow waits after failed polls.
+	DefaultFollowMaxBackoff = 5 * time.Minute
+
+	// seenLimit is the number of emitted activity log IDs remembered for deduplication.
+	seenLimit = 1000
+)
+
+// follower holds the state of a single Follow call.
+type follower struct {
+	client Client
+	opts   doppler.ActivityLogFollowOptions
+
+	cursor doppler.ActivityLogCursor
+	seen   map[string]bool
+	order  []string // IDs in seen, oldest first, to prune the set.
+}
+
+// remember marks the given activity log as seen and moves the cursor to it.
+func (f *follower) remember(log *doppler.ActivityLog) {
+	cursor := log.Cursor()
+	if cursor.ID != "" && !f.seen[cursor.ID] {
+		f.seen[cursor.ID] = true
+		f.order = append(f.order, cursor.ID)
+		if len(f.order) > seenLimit {
+			delete(f.seen, f.order[0])
+			f.order = f.order[1:]
+		}
+	}
+	f.cursor = cursor
+}
+
+// isOld reports whether the given activity log is at or before the cursor.
+func (f *follower) isOld(log *doppler.ActivityLog) bool {
+	cursor := log.Cursor()
+	switch {
+	case cursor.ID != "" && (f.seen[cursor.ID] || cursor.ID == f.cursor.ID):
+		return true
+	case f.cursor.CreatedAt == nil || cursor.CreatedAt == nil:
+		return false
+	case cursor.CreatedAt.Before(*f.cursor.CreatedAt):
+		return true
+	default:
+		// Logs created at the very time of a cursor without ID can't be told apart from the cursor's log.
+		return f.cursor.ID == "" && cursor.CreatedAt.Equal(*f.cursor.CreatedAt)
+	}
+}
+
+// poll returns the activity logs created after the cursor, oldest first. Unless firstPage is set, it walks further
+// pages as long as they contain new logs only, so that a follower catches up after downtime.
+func (f *follower) poll(ctx context.Context, firstPage bool) ([]*doppler.ActivityLog, doppler.APIResponse, error) {
+	var fresh []*doppler.ActivityLog
+	batch := make(map[string]bool)
+	for page := 1; ; page++ {
+		logs, resp, err := f.client.fetchList(ctx, &doppler.ActivityLogListOptions{
+			ListOptions: doppler.ListOptions{Page: page, PerPage: f.opts.PerPage},
+		})
+		if err != nil {
+			return nil, resp, errors.Wrapf(err, "list activity logs page %d", page)
+		}
+
+		for _, log := range logs {
+			if f.isOld(log) {
+				return reverse(fresh), resp, nil
+			}
+			// Logs created while paging shift the pages, so a log may show up twice.
+			if log.ID != nil {
+				if batch[*log.ID] {
+					continue
+				}
+				batch[*log.ID] = true
+			}
+			fresh = append(fresh, log)
+		}
+
+		if firstPage || len(logs) < f.opts.PerPage {
+			return reverse(fresh), resp, nil
+		}
+	}
+}
+
+// reverse reverses the given activity logs in place and returns them.
+func reverse(logs []*doppler.ActivityLog) []*doppler.ActivityLog {
+	for i, j := 0, len(logs)-1; i < j; i, j = i+1, j-1 {
+		logs[i], logs[j] = logs[j], logs[i]
+	}
+
+	return logs
+}
+
+// backoff returns the time to wait after a failed poll. Rate limited polls wait for the rate limit to reset, other
+// failures back off exponentially.
+func (f *follower) backoff(resp doppler.APIResponse, failures int) time.Duration {
+	rateLimited := resp.StatusCode == http.StatusTooManyRequests
+	if rateLimited && resp.RateLimit != nil {
+		if wait := time.Until(resp.RateLimit.Reset); wait > 0 {
+			if wait > f.opts.MaxBackoff {
+				return f.opts.MaxBackoff
+			}
+			return wait
+		}
+	}
+
+	// Without a reset time, rate limited polls back off exponentially as well. Polling again after the usual interval
+	// is what got them limited, so they start at twice the interval.
+	if rateLimited {
+		failures++
+	}
+
+	wait := f.opts.Interval
+	for i := 0; i < failures && wait < f.opts.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > f.opts.MaxBackoff {
+		wait = f.opts.MaxBackoff
+	}
+
+	return wait
+}
+
+// run polls the activity logs until the context is canceled and sends new ones to the given channel.
+func (f *follower) run(ctx context.Context, ch chan<- *doppler.ActivityLog) {
+	defer close(ch)
+
+	// Without a cursor, only logs created from now on are followed. Skip the current ones, retrying until it worked.
+	skip := f.cursor == (doppler.ActivityLogCursor{})
+
+	var failures int
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		logs, resp, err := f.poll(ctx, skip)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			if f.opts.OnError != nil {
+				f.opts.OnError(err)
+			}
+			timer.Reset(f.backoff(resp, failures))
+			failures++
+			continue
+		}
+		failures = 0
+
+		for _, log := range logs {
+			if !skip {
+				select {
+				case ch <- log:
+				case <-ctx.Done():
+					return
+				}
+			}
+			f.remember(log)
+		}
+		skip = false
+
+		timer.Reset(f.opts.Interval)
+	}
+}
+
+// Follow polls the first page of the activity logs and sends every new log to the returned channel, oldest first and
+// without duplicates. It follows the logs created from now on, or resumes after opts.Cursor; persist the cursor of
+// the last received log to resume after a restart. Failed polls are reported to opts.OnError and retried after
+// backing off; rate limited polls wait until the rate limit resets, if the API tells when. The channel is closed once the context is
+// canceled.
+func (c Client) Follow(ctx context.Context, opts *doppler.ActivityLogFollowOptions) (<-chan *doppler.ActivityLog, error) {
+	f := &follower{client: c, seen: make(map[string]bool)}
+	if opts != nil {
+		f.opts = *opts
+	}
+	if f.opts.Interval < 0 || f.opts.PerPage < 0 || f.opts.MaxBackoff < 0 {
+		return nil, errors.New("follow options may not be negative")
+	}
+	if f.opts.Interval == 0 {
+		f.opts.Interval = DefaultFollowInterval
+	}
+	if f.opts.PerPage == 0 {
+		f.opts.PerPage = DefaultFollowPerPage
+	}
+	if f.opts.MaxBackoff == 0 {
+		f.opts.MaxBackoff = DefaultFollowMaxBackoff
+	}
+	if f.opts.Cursor != nil {
+		f.cursor = *f.opts.Cursor
+	}
+
+	ch := make(chan *doppler.ActivityLog)
+	go f.run(ctx, ch)
+
+	return ch, nil
+}
+
+// Follow sends every new activity log to the returned channel using the default client.
+func Follow(ctx context.Context, opts *doppler.ActivityLogFollowOptions) (<-chan *doppler.ActivityLog, error) {
+	return Default().Follow(ctx, opts)
+}
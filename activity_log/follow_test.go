@@ -0,0 +1,331 @@
+package activitylog_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/nikoksr/doppler-go"
+	activitylog "github.com/nikoksr/doppler-go/activity_log"
+	"github.com/nikoksr/doppler-go/pointer"
+)
+
+// followServer mocks the activity log list endpoint. Before serving the first page of a poll, it prepends the next of
+// the pending batches to its logs, simulating activity between polls.
+type followServer struct {
+	mu          sync.Mutex
+	logs        []*doppler.ActivityLog // Newest first.
+	pending     [][]*doppler.ActivityLog
+	rateLimited int         // Number of polls to answer with 429 before serving logs.
+	noHeaders   bool        // Answer rate limited polls without rate limit headers.
+	requests    int         // Number of served requests.
+	times       []time.Time // Times the requests were received at.
+}
+
+func (s *followServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+	s.times = append(s.times, time.Now())
+	w.Header().Set("Content-Type", "application/json")
+
+	if s.rateLimited > 0 {
+		s.rateLimited--
+		if !s.noHeaders {
+			w.Header().Set("X-RateLimit-Limit", "60")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(-time.Second).Unix(), 10))
+		}
+		w.WriteHeader(http.StatusTooManyRequests)
+		_ = json.NewEncoder(w).Encode(&doppler.ActivityLogListResponse{
+			APIResponse: doppler.APIResponse{Success: pointer.To(false), Messages: []string{"Too many requests"}},
+		})
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if page == 1 && len(s.pending) > 0 {
+		batch := s.pending[0]
+		s.pending = s.pending[1:]
+		s.logs = append(append([]*doppler.ActivityLog{}, batch...), s.logs...)
+	}
+
+	start, end := (page-1)*perPage, page*perPage
+	if start > len(s.logs) {
+		start = len(s.logs)
+	}
+	if end > len(s.logs) {
+		end = len(s.logs)
+	}
+	_ = json.NewEncoder(w).Encode(&doppler.ActivityLogListResponse{
+		APIResponse:  doppler.APIResponse{Success: pointer.To(true)},
+		ActivityLogs: s.logs[start:end],
+	})
+}
+
+// followLog returns an activity log with the given ID, created the given number of minutes after a fixed time.
+func followLog(id string, minute int) *doppler.ActivityLog {
+	createdAt := time.Date(2023, 1, 1, 0, minute, 0, 0, time.UTC)
+	return &doppler.ActivityLog{ID: pointer.To(id), CreatedAt: pointer.To(createdAt.Format(time.RFC3339))}
+}
+
+// collect receives the IDs of n activity logs from the channel, or fails after a timeout.
+func collect(t *testing.T, logs <-chan *doppler.ActivityLog, n int) []string {
+	t.Helper()
+
+	var ids []string
+	timeout := time.After(5 * time.Second)
+	for len(ids) < n {
+		select {
+		case log, ok := <-logs:
+			if !ok {
+				t.Fatalf("Channel closed after %d of %d activity logs", len(ids), n)
+			}
+			ids = append(ids, *log.ID)
+		case <-timeout:
+			t.Fatalf("Timed out after %d of %d activity logs: %v", len(ids), n, ids)
+		}
+	}
+
+	return ids
+}
+
+func TestActivityLog_Follow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cursor  *doppler.ActivityLogCursor
+		perPage int
+		logs    []*doppler.ActivityLog
+		pending [][]*doppler.ActivityLog
+		wantIDs []string
+	}{
+		{
+			name: "Follow from now on",
+			logs: []*doppler.ActivityLog{followLog("2", 2), followLog("1", 1)},
+			pending: [][]*doppler.ActivityLog{
+				{followLog("3", 3)},
+				{followLog("5", 5), followLog("4", 4)},
+				{},
+				{followLog("6", 6)},
+			},
+			wantIDs: []string{"4", "5", "6"},
+		},
+		{
+			name:   "Resume after cursor ID",
+			cursor: &doppler.ActivityLogCursor{ID: "2"},
+			logs:   []*doppler.ActivityLog{followLog("3", 3), followLog("2", 2), followLog("1", 1)},
+			pending: [][]*doppler.ActivityLog{
+				{},
+				{followLog("4", 4)},
+			},
+			wantIDs: []string{"3", "4"},
+		},
+		{
+			name:    "Resume after cursor time",
+			cursor:  &doppler.ActivityLogCursor{CreatedAt: pointer.To(time.Date(2023, 1, 1, 0, 2, 0, 0, time.UTC))},
+			logs:    []*doppler.ActivityLog{followLog("4", 4), followLog("3", 3), followLog("2", 2), followLog("1", 1)},
+			wantIDs: []string{"3", "4"},
+		},
+		{
+			name:    "Catch up over multiple pages",
+			cursor:  &doppler.ActivityLogCursor{ID: "1", CreatedAt: pointer.To(time.Date(2023, 1, 1, 0, 1, 0, 0, time.UTC))},
+			perPage: 2,
+			logs:    []*doppler.ActivityLog{followLog("1", 1)},
+			pending: [][]*doppler.ActivityLog{
+				{followLog("6", 6), followLog("5", 5), followLog("4", 4), followLog("3", 3), followLog("2", 2)},
+				{followLog("7", 7)},
+			},
+			wantIDs: []string{"2", "3", "4", "5", "6", "7"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := httptest.NewServer(&followServer{logs: tt.logs, pending: tt.pending})
+			defer ts.Close()
+
+			client := &activitylog.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			logs, err := client.Follow(ctx, &doppler.ActivityLogFollowOptions{
+				Cursor:   tt.cursor,
+				Interval: 10 * time.Millisecond,
+				PerPage:  tt.perPage,
+				OnError:  func(err error) { t.Errorf("Unexpected error: %v", err) },
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.wantIDs, collect(t, logs, len(tt.wantIDs))); diff != "" {
+				t.Errorf("Unexpected activity logs (-want +got):\n%s", diff)
+			}
+
+			// Later polls must not repeat any of the activity logs.
+			select {
+			case log := <-logs:
+				t.Errorf("Unexpected activity log %q", *log.ID)
+			case <-time.After(50 * time.Millisecond):
+			}
+		})
+	}
+}
+
+func TestActivityLog_FollowRateLimited(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(&followServer{
+		logs:        []*doppler.ActivityLog{followLog("1", 1)},
+		pending:     [][]*doppler.ActivityLog{{followLog("2", 2)}},
+		rateLimited: 2,
+	})
+	defer ts.Close()
+
+	client := &activitylog.Client{
+		Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+			URL: pointer.To(ts.URL),
+		}),
+		Key: "test",
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	var failures int
+	logs, err := client.Follow(ctx, &doppler.ActivityLogFollowOptions{
+		Cursor:     &doppler.ActivityLogCursor{ID: "1"},
+		Interval:   time.Millisecond,
+		MaxBackoff: 20 * time.Millisecond,
+		OnError: func(err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			failures++
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff([]string{"2"}, collect(t, logs, 1)); diff != "" {
+		t.Errorf("Unexpected activity logs (-want +got):\n%s", diff)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if failures != 2 {
+		t.Errorf("Expected 2 failed polls, got %d", failures)
+	}
+}
+
+func TestActivityLog_FollowRateLimitedWithoutHeaders(t *testing.T) {
+	t.Parallel()
+
+	srv := &followServer{
+		logs:        []*doppler.ActivityLog{followLog("1", 1)},
+		pending:     [][]*doppler.ActivityLog{{followLog("2", 2)}},
+		rateLimited: 2,
+		noHeaders:   true,
+	}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	client := &activitylog.Client{
+		Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+			URL: pointer.To(ts.URL),
+		}),
+		Key: "test",
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	interval := 10 * time.Millisecond
+	logs, err := client.Follow(ctx, &doppler.ActivityLogFollowOptions{
+		Cursor:     &doppler.ActivityLogCursor{ID: "1"},
+		Interval:   interval,
+		MaxBackoff: time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff([]string{"2"}, collect(t, logs, 1)); diff != "" {
+		t.Errorf("Unexpected activity logs (-want +got):\n%s", diff)
+	}
+
+	// The polls after the rate limited ones must back off exponentially, starting at twice the interval.
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if len(srv.times) < 3 {
+		t.Fatalf("Expected at least 3 requests, got %d", len(srv.times))
+	}
+	for i, want := range []time.Duration{2 * interval, 4 * interval} {
+		if got := srv.times[i+1].Sub(srv.times[i]); got < want {
+			t.Errorf("Expected poll %d to wait at least %s, waited %s", i+2, want, got)
+		}
+	}
+}
+
+func TestActivityLog_FollowCancel(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(&followServer{})
+	defer ts.Close()
+
+	client := &activitylog.Client{
+		Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+			URL: pointer.To(ts.URL),
+		}),
+		Key: "test",
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	logs, err := client.Follow(ctx, &doppler.ActivityLogFollowOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	cancel()
+
+	select {
+	case _, ok := <-logs:
+		if ok {
+			t.Error("Expected no activity log after cancellation")
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("Expected channel to be closed after cancellation")
+	}
+}
+
+func TestActivityLog_FollowInvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	logs, err := activitylog.Follow(context.Background(), &doppler.ActivityLogFollowOptions{Interval: -time.Second})
+	if err == nil {
+		t.Error("Expected error for negative interval")
+	}
+	if logs != nil {
+		t.Error("Expected no channel for invalid options")
+	}
+}
@@ -0,0 +1,52 @@
+package activitylog
+
+import (
+	"context"
+
+	"github.com/nikoksr/doppler-go"
+)
+
+// queryIterator iterates over the activity logs matching a query.
+type queryIterator struct {
+	it    doppler.Iterator[*doppler.ActivityLog]
+	query doppler.ActivityLogQuery
+	done  bool
+}
+
+// Next returns the next matching activity log. Since logs are listed newest first, iteration ends at the first log
+// created before the query's time window, without fetching further pages.
+func (it *queryIterator) Next() (*doppler.ActivityLog, error) {
+	for !it.done {
+		log, err := it.it.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		createdAt := log.Cursor().CreatedAt
+		if !it.query.Since.IsZero() && createdAt != nil && createdAt.Before(it.query.Since) {
+			it.done = true
+			break
+		}
+		if it.query.Match(log) {
+			return log, nil
+		}
+	}
+
+	return nil, doppler.ErrIteratorDone
+}
+
+// Query returns an iterator over the activity logs matching the query, newest first. The logs are filtered
+// client-side; paging stops once the logs are older than the query's time window.
+func (c Client) Query(ctx context.Context, query *doppler.ActivityLogQuery) doppler.Iterator[*doppler.ActivityLog] {
+	it := &queryIterator{it: c.Iter(ctx, nil)}
+	if query != nil {
+		it.query = *query
+	}
+
+	return it
+}
+
+// Query returns an iterator over the activity logs matching the query using the default client.
+func Query(ctx context.Context, query *doppler.ActivityLogQuery) doppler.Iterator[*doppler.ActivityLog] {
+	return Default().Query(ctx, query)
+}
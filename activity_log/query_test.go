@@ -0,0 +1,112 @@
+package activitylog_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/nikoksr/doppler-go"
+	activitylog "github.com/nikoksr/doppler-go/activity_log"
+	"github.com/nikoksr/doppler-go/pointer"
+)
+
+func TestActivityLog_Query(t *testing.T) {
+	t.Parallel()
+
+	// 250 logs, newest first, one per minute. Every tenth log was created by a different user in prd.
+	var logs []*doppler.ActivityLog
+	for i := 249; i >= 0; i-- {
+		log := followLog(strconv.Itoa(i), i)
+		log.Text = pointer.To("Updated secrets")
+		log.Project = pointer.To("backend")
+		log.Config = pointer.To("dev")
+		log.User = &doppler.User{Email: pointer.To("dev@example.com")}
+		if i%10 == 0 {
+			log.Text = pointer.To("Rotated DB_PASSWORD")
+			log.Config = pointer.To("prd")
+			log.User = &doppler.User{Email: pointer.To("ops@example.com")}
+		}
+		logs = append(logs, log)
+	}
+	at := func(minute int) time.Time {
+		return time.Date(2023, 1, 1, 0, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name         string
+		query        *doppler.ActivityLogQuery
+		wantIDs      []string
+		wantRequests int
+	}{
+		{
+			name:         "Config in time window",
+			query:        &doppler.ActivityLogQuery{Project: "backend", Config: "prd", Since: at(200), Until: at(240)},
+			wantIDs:      []string{"230", "220", "210", "200"},
+			wantRequests: 1,
+		},
+		{
+			name:         "User email and text",
+			query:        &doppler.ActivityLogQuery{UserEmail: "OPS@example.com", Text: regexp.MustCompile(`DB_\w+`), Since: at(120)},
+			wantIDs:      []string{"240", "230", "220", "210", "200", "190", "180", "170", "160", "150", "140", "130", "120"},
+			wantRequests: 2,
+		},
+		{
+			name:         "Without time window",
+			query:        &doppler.ActivityLogQuery{Config: "prd", Text: regexp.MustCompile(`^Rotated`), UserEmail: "ops@example.com", Until: at(30)},
+			wantIDs:      []string{"20", "10", "0"},
+			wantRequests: 3,
+		},
+		{
+			name:         "No matches",
+			query:        &doppler.ActivityLogQuery{Project: "frontend", Since: at(245)},
+			wantRequests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := &followServer{logs: logs}
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			client := &activitylog.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			it := client.Query(context.Background(), tt.query)
+			var gotIDs []string
+			for {
+				log, err := it.Next()
+				if errors.Is(err, doppler.ErrIteratorDone) {
+					break
+				}
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				gotIDs = append(gotIDs, *log.ID)
+			}
+
+			if diff := cmp.Diff(tt.wantIDs, gotIDs); diff != "" {
+				t.Errorf("Unexpected activity logs (-want +got):\n%s", diff)
+			}
+
+			srv.mu.Lock()
+			defer srv.mu.Unlock()
+			if srv.requests != tt.wantRequests {
+				t.Errorf("Expected %d requests, got %d", tt.wantRequests, srv.requests)
+			}
+		})
+	}
+}
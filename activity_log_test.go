@@ -0,0 +1,83 @@
+package doppler
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/nikoksr/doppler-go/pointer"
+)
+
+func TestActivityLogQuery_Match(t *testing.T) {
+	t.Parallel()
+
+	log := &ActivityLog{
+		Text:      pointer.To("Updated DB_PASSWORD in prd"),
+		User:      &User{Email: pointer.To("Jane@example.com")},
+		Project:   pointer.To("backend"),
+		Config:    pointer.To("prd"),
+		CreatedAt: pointer.To("2023-01-02T12:00:00Z"),
+	}
+	noon := time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		query ActivityLogQuery
+		log   *ActivityLog
+		want  bool
+	}{
+		{name: "Empty query", log: &ActivityLog{}, want: true},
+		{name: "Project and config", query: ActivityLogQuery{Project: "backend", Config: "prd"}, log: log, want: true},
+		{name: "Other config", query: ActivityLogQuery{Config: "dev"}, log: log, want: false},
+		{name: "Missing environment", query: ActivityLogQuery{Environment: "prd"}, log: log, want: false},
+		{name: "User email ignoring case", query: ActivityLogQuery{UserEmail: "jane@EXAMPLE.com"}, log: log, want: true},
+		{name: "Missing user", query: ActivityLogQuery{UserEmail: "jane@example.com"}, log: &ActivityLog{}, want: false},
+		{name: "Text", query: ActivityLogQuery{Text: regexp.MustCompile(`DB_\w+`)}, log: log, want: true},
+		{name: "Other text", query: ActivityLogQuery{Text: regexp.MustCompile(`^Deleted`)}, log: log, want: false},
+		{name: "Since is inclusive", query: ActivityLogQuery{Since: noon}, log: log, want: true},
+		{name: "Until is exclusive", query: ActivityLogQuery{Until: noon}, log: log, want: false},
+		{name: "In time window", query: ActivityLogQuery{Since: noon.Add(-time.Hour), Until: noon.Add(time.Hour)}, log: log, want: true},
+		{name: "Invalid creation time", query: ActivityLogQuery{Since: noon}, log: &ActivityLog{CreatedAt: pointer.To("yesterday")}, want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.query.Match(tt.log); got != tt.want {
+				t.Errorf("Expected match to be %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestActivityLog_Cursor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		log      *ActivityLog
+		wantJSON string
+	}{
+		{name: "Log with ID and time", log: &ActivityLog{ID: pointer.To("1"), CreatedAt: pointer.To("2023-01-02T12:00:00Z")}, wantJSON: `{"id":"1","created_at":"2023-01-02T12:00:00Z"}`},
+		{name: "Log with invalid time", log: &ActivityLog{ID: pointer.To("1"), CreatedAt: pointer.To("yesterday")}, wantJSON: `{"id":"1"}`},
+		{name: "Empty log", log: &ActivityLog{}, wantJSON: `{}`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := json.Marshal(tt.log.Cursor())
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if string(got) != tt.wantJSON {
+				t.Errorf("Unexpected cursor JSON. Expected %s, got %s", tt.wantJSON, got)
+			}
+		})
+	}
+}
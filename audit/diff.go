@@ -0,0 +1,107 @@
+package audit
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+type (
+	// GrantChange represents a grant whose access changed between two reports.
+	GrantChange struct {
+		Before Grant `json:"before"` // The grant in the older report.
+		After  Grant `json:"after"`  // The grant in the newer report.
+	}
+
+	// ReportDiff represents the access changes between two reports.
+	ReportDiff struct {
+		Added   []Grant       `json:"added"`   // Grants only in the newer report.
+		Removed []Grant       `json:"removed"` // Grants only in the older report.
+		Changed []GrantChange `json:"changed"` // Grants whose access level, environments or expiration changed.
+	}
+)
+
+// Diff returns the access changes from the older to the newer report. The last use of service tokens isn't compared.
+func Diff(from, to *Report) *ReportDiff {
+	diff := &ReportDiff{}
+
+	before := make(map[string]Grant)
+	for _, g := range from.Grants() {
+		before[g.key()] = g
+	}
+
+	for _, g := range to.Grants() {
+		old, ok := before[g.key()]
+		delete(before, g.key())
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, g)
+		case old.Access != g.Access || old.Environments != g.Environments || old.ExpiresAt != g.ExpiresAt:
+			diff.Changed = append(diff.Changed, GrantChange{Before: old, After: g})
+		}
+	}
+
+	// Keep the order of the older report for removed grants.
+	for _, g := range from.Grants() {
+		if _, ok := before[g.key()]; ok {
+			diff.Removed = append(diff.Removed, g)
+		}
+	}
+
+	return diff
+}
+
+// Empty reports whether the diff contains no changes.
+func (d *ReportDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// describe returns a short description of the grant's access.
+func (g Grant) describe() string {
+	access := g.Access
+	if g.Environments != "" {
+		access += " (" + g.Environments + ")"
+	}
+	if g.ExpiresAt != "" {
+		access += ", expires " + g.ExpiresAt
+	}
+
+	return access
+}
+
+// WriteMarkdown writes the diff as markdown document with a table each for granted, revoked and changed access.
+func (d *ReportDiff) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("# Access changes\n")
+
+	header := []string{"Type", "Principal", "Scope", "Access"}
+	grantRows := func(grants []Grant) [][]string {
+		rows := make([][]string, 0, len(grants))
+		for _, g := range grants {
+			rows = append(rows, []string{g.PrincipalType, g.Principal, g.Scope, g.describe()})
+		}
+		return rows
+	}
+
+	b.WriteString("\n## Granted\n\n")
+	table(&b, header, grantRows(d.Added))
+
+	b.WriteString("\n## Revoked\n\n")
+	table(&b, header, grantRows(d.Removed))
+
+	b.WriteString("\n## Changed\n\n")
+	rows := make([][]string, 0, len(d.Changed))
+	for _, c := range d.Changed {
+		rows = append(rows, []string{c.After.PrincipalType, c.After.Principal, c.After.Scope, c.Before.describe(), c.After.describe()})
+	}
+	table(&b, []string{"Type", "Principal", "Scope", "Before", "After"}, rows)
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+// String returns a one-line summary of the diff.
+func (d *ReportDiff) String() string {
+	return fmt.Sprintf("%d grants added, %d removed, %d changed", len(d.Added), len(d.Removed), len(d.Changed))
+}
@@ -12,5 +12,19 @@ Example:
 			}
 
 			fmt.Println(logs)
+
+	// Generate the quarterly access review and show what changed since the last one
+	report, err := audit.GenerateReport(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := report.WriteMarkdown(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+
+	diff := audit.Diff(lastQuarter, report)
+	if !diff.Empty() {
+		_ = diff.WriteMarkdown(os.Stdout)
+	}
 */
 package audit
@@ -0,0 +1,193 @@
+package audit
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/nikoksr/doppler-go"
+)
+
+// PrincipalTypeServiceToken is the principal type of service token grants.
+const PrincipalTypeServiceToken = "service_token"
+
+// ScopeWorkplace is the scope of grants to the whole workplace.
+const ScopeWorkplace = "workplace"
+
+// Grant represents a principal's access to a scope: the workplace, a project or a config.
+type Grant struct {
+	PrincipalType string `json:"principal_type"`         // Type of the principal, e.g. workplace_user, group or service_token.
+	Principal     string `json:"principal"`              // Email of users, slug of other principals.
+	Name          string `json:"name,omitempty"`         // Display name of the principal.
+	Scope         string `json:"scope"`                  // "workplace", the project, or "project/config" for service tokens.
+	Access        string `json:"access"`                 // Access level or role.
+	Environments  string `json:"environments,omitempty"` // Accessible environments of project grants; "all" or a comma-separated list.
+	ExpiresAt     string `json:"expires_at,omitempty"`   // Expiration of service tokens.
+	LastSeenAt    string `json:"last_seen_at,omitempty"` // Last use of service tokens, if reported by the API.
+}
+
+// key returns the identity of the grant; the same principal's access to the same scope.
+func (g Grant) key() string {
+	return g.PrincipalType + "\x00" + g.Principal + "\x00" + g.Scope
+}
+
+// environments returns the accessible environments of the project access as string.
+func (a *ProjectAccess) environments() string {
+	if a.AllEnvironments {
+		return "all"
+	}
+
+	return strings.Join(a.Environments, ",")
+}
+
+// Grants returns the report as a flat list of grants, in the order of the report's entries.
+func (r *Report) Grants() []Grant {
+	var grants []Grant
+	for _, user := range r.Users {
+		principal := user.Email
+		if principal == "" {
+			principal = user.ID
+		}
+		grants = append(grants, Grant{
+			PrincipalType: doppler.MemberTypeWorkplaceUser,
+			Principal:     principal,
+			Name:          user.Name,
+			Scope:         ScopeWorkplace,
+			Access:        user.Access,
+		})
+		for _, project := range user.Projects {
+			grants = append(grants, Grant{
+				PrincipalType: doppler.MemberTypeWorkplaceUser,
+				Principal:     principal,
+				Name:          user.Name,
+				Scope:         project.Project,
+				Access:        project.Role,
+				Environments:  project.environments(),
+			})
+		}
+	}
+	for _, member := range r.Members {
+		grants = append(grants, Grant{
+			PrincipalType: member.Type,
+			Principal:     member.Slug,
+			Scope:         member.Project,
+			Access:        member.Role,
+			Environments:  member.environments(),
+		})
+	}
+	for _, token := range r.ServiceTokens {
+		grants = append(grants, Grant{
+			PrincipalType: PrincipalTypeServiceToken,
+			Principal:     token.Slug,
+			Name:          token.Name,
+			Scope:         token.Project + "/" + token.Config,
+			Access:        token.Access,
+			ExpiresAt:     token.ExpiresAt,
+			LastSeenAt:    token.LastSeenAt,
+		})
+	}
+
+	return grants
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(r)
+}
+
+// WriteCSV writes the grants of the report as CSV, one grant per row.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"principal_type", "principal", "name", "scope", "access", "environments", "expires_at", "last_seen_at"}); err != nil {
+		return err
+	}
+	for _, g := range r.Grants() {
+		if err := cw.Write([]string{g.PrincipalType, g.Principal, g.Name, g.Scope, g.Access, g.Environments, g.ExpiresAt, g.LastSeenAt}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// markdownEscape escapes a markdown table cell.
+var markdownEscape = strings.NewReplacer(`|`, `\|`, "\r\n", " ", "\n", " ", "\r", " ")
+
+// table writes a markdown table; empty cells are written as dash.
+func table(b *strings.Builder, header []string, rows [][]string) {
+	if len(rows) == 0 {
+		b.WriteString("None.\n")
+		return
+	}
+
+	b.WriteString("| " + strings.Join(header, " | ") + " |\n")
+	b.WriteString("|" + strings.Repeat(" --- |", len(header)) + "\n")
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = "-"
+			if cell != "" {
+				cells[i] = markdownEscape.Replace(cell)
+			}
+		}
+		b.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	}
+}
+
+// enabled returns a human-readable form of the flag.
+func enabled(flag bool) string {
+	if flag {
+		return "enabled"
+	}
+
+	return "disabled"
+}
+
+// WriteMarkdown writes the report as markdown document with a table each for users, other project members and
+// service tokens.
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Access review: %s\n\n", markdownEscape.Replace(r.Workplace.Name))
+	fmt.Fprintf(&b, "Generated at %s. SAML is %s, SCIM is %s.\n", r.GeneratedAt.Format(time.RFC3339),
+		enabled(r.Workplace.SAMLEnabled), enabled(r.Workplace.SCIMEnabled))
+
+	b.WriteString("\n## Users\n\n")
+	rows := make([][]string, 0, len(r.Users))
+	for _, user := range r.Users {
+		projects := make([]string, len(user.Projects))
+		for i, project := range user.Projects {
+			projects[i] = fmt.Sprintf("%s (%s: %s)", project.Project, project.Role, project.environments())
+		}
+		rows = append(rows, []string{user.Email, user.Name, user.Access, user.AddedAt, strings.Join(projects, ", ")})
+	}
+	table(&b, []string{"Email", "Name", "Workplace access", "Added", "Projects"}, rows)
+
+	b.WriteString("\n## Project members\n\n")
+	rows = make([][]string, 0, len(r.Members))
+	for _, member := range r.Members {
+		rows = append(rows, []string{member.Project, member.Type, member.Slug, member.Role, member.environments()})
+	}
+	table(&b, []string{"Project", "Type", "Member", "Role", "Environments"}, rows)
+
+	b.WriteString("\n## Service tokens\n\n")
+	rows = make([][]string, 0, len(r.ServiceTokens))
+	for _, token := range r.ServiceTokens {
+		expiresAt := token.ExpiresAt
+		if expiresAt == "" {
+			expiresAt = "never"
+		}
+		rows = append(rows, []string{token.Project, token.Config, token.Name, token.Access, token.CreatedAt, expiresAt, token.LastSeenAt})
+	}
+	table(&b, []string{"Project", "Config", "Name", "Access", "Created", "Expires", "Last used"}, rows)
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
@@ -0,0 +1,290 @@
+package audit
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/config"
+	"github.com/nikoksr/doppler-go/pointer"
+	"github.com/nikoksr/doppler-go/project"
+	projectmember "github.com/nikoksr/doppler-go/project_member"
+	servicetoken "github.com/nikoksr/doppler-go/service_token"
+)
+
+type (
+	// ReportWorkplace represents the workplace of an access review report.
+	ReportWorkplace struct {
+		ID          string `json:"id"`           // Unique identifier of the workplace.
+		Name        string `json:"name"`         // Name of the workplace.
+		SAMLEnabled bool   `json:"saml_enabled"` // Whether SAML SSO is enabled.
+		SCIMEnabled bool   `json:"scim_enabled"` // Whether SCIM user provisioning is enabled.
+	}
+
+	// ProjectAccess represents the access of a principal to a project.
+	ProjectAccess struct {
+		Project         string   `json:"project"`                // Identifier of the project.
+		Role            string   `json:"role"`                   // Identifier of the project role.
+		AllEnvironments bool     `json:"all_environments"`       // Whether all environments of the project are accessible.
+		Environments    []string `json:"environments,omitempty"` // Accessible environments, if not all.
+	}
+
+	// ReportUser represents a workplace user and their access.
+	ReportUser struct {
+		ID       string           `json:"id"`                 // Unique identifier of the workplace user.
+		Email    string           `json:"email"`              // Email address of the user.
+		Name     string           `json:"name"`               // Name of the user.
+		Access   string           `json:"access"`             // Access level of the user in the workplace.
+		AddedAt  string           `json:"added_at,omitempty"` // Date and time the user was added to the workplace.
+		Projects []*ProjectAccess `json:"projects,omitempty"` // Project memberships of the user, sorted by project.
+	}
+
+	// ReportMember represents a project member that isn't a workplace user, e.g. a group or service account.
+	ReportMember struct {
+		Type          string `json:"type"` // Type of the member, e.g. group or service_account.
+		Slug          string `json:"slug"` // Unique identifier of the member.
+		ProjectAccess `json:",inline"`
+	}
+
+	// ReportServiceToken represents a service token and the config it grants access to.
+	ReportServiceToken struct {
+		Name        string `json:"name"`                   // Name of the service token.
+		Slug        string `json:"slug"`                   // Unique identifier of the service token.
+		Project     string `json:"project"`                // Identifier of the project.
+		Environment string `json:"environment,omitempty"`  // Identifier of the environment.
+		Config      string `json:"config"`                 // Name of the config.
+		Access      string `json:"access"`                 // Access level of the service token.
+		CreatedAt   string `json:"created_at,omitempty"`   // Date and time of the token's creation.
+		ExpiresAt   string `json:"expires_at,omitempty"`   // Date and time of the token's expiration; empty if it doesn't expire.
+		LastSeenAt  string `json:"last_seen_at,omitempty"` // Date and time of the token's last use, if reported by the API.
+	}
+
+	// Report represents an access review of a workplace: who can access what, and how.
+	Report struct {
+		GeneratedAt   time.Time             `json:"generated_at"`   // Date and time the report was generated.
+		Workplace     ReportWorkplace       `json:"workplace"`      // The reviewed workplace.
+		Users         []*ReportUser         `json:"users"`          // Workplace users, sorted by email.
+		Members       []*ReportMember       `json:"members"`        // Other project members, sorted by project, type and slug.
+		ServiceTokens []*ReportServiceToken `json:"service_tokens"` // Service tokens, sorted by project, config and name.
+	}
+)
+
+// value returns the value of the given string pointer, or an empty string if it's nil.
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// collect returns all items of the iterator.
+func collect[T any](it doppler.Iterator[T]) ([]T, error) {
+	var items []T
+	for {
+		item, err := it.Next()
+		if errors.Is(err, doppler.ErrIteratorDone) {
+			return items, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+}
+
+func (c Client) listProjects(ctx context.Context) ([]*doppler.Project, error) {
+	projects := &project.Client{Backend: c.Backend, Key: c.Key}
+	return collect(doppler.NewPageIterator(ctx, doppler.ListOptions{}, func(ctx context.Context, page doppler.ListOptions) ([]*doppler.Project, error) {
+		list, _, err := projects.List(ctx, &doppler.ProjectListOptions{ListOptions: page})
+		return list, err
+	}))
+}
+
+func (c Client) listProjectMembers(ctx context.Context, project string) ([]*doppler.ProjectMember, error) {
+	members := &projectmember.Client{Backend: c.Backend, Key: c.Key}
+	return collect(doppler.NewPageIterator(ctx, doppler.ListOptions{}, func(ctx context.Context, page doppler.ListOptions) ([]*doppler.ProjectMember, error) {
+		list, _, err := members.List(ctx, &doppler.ProjectMemberListOptions{ListOptions: page, Project: project})
+		return list, err
+	}))
+}
+
+func (c Client) listConfigs(ctx context.Context, project string) ([]*doppler.Config, error) {
+	configs := &config.Client{Backend: c.Backend, Key: c.Key}
+	return collect(doppler.NewPageIterator(ctx, doppler.ListOptions{}, func(ctx context.Context, page doppler.ListOptions) ([]*doppler.Config, error) {
+		list, _, err := configs.List(ctx, &doppler.ConfigListOptions{ListOptions: page, Project: project})
+		return list, err
+	}))
+}
+
+func (c Client) listServiceTokens(ctx context.Context, project, config string) ([]*doppler.ServiceToken, error) {
+	tokens := &servicetoken.Client{Backend: c.Backend, Key: c.Key}
+	list, _, err := tokens.List(ctx, &doppler.ServiceTokenListOptions{Project: project, Config: config})
+
+	return list, err
+}
+
+// projectAccess returns the access a project member has to the project.
+func projectAccess(project string, member *doppler.ProjectMember) ProjectAccess {
+	access := ProjectAccess{Project: project}
+	if member.Role != nil {
+		access.Role = value(member.Role.Identifier)
+	}
+	access.AllEnvironments = member.AccessAllEnvironments != nil && *member.AccessAllEnvironments
+	if !access.AllEnvironments {
+		access.Environments = append([]string(nil), member.Environments...)
+		sort.Strings(access.Environments)
+	}
+
+	return access
+}
+
+// projectReport adds the members and service tokens of the project to the report.
+func (c Client) projectReport(ctx context.Context, report *Report, users map[string]*ReportUser, project string) error {
+	members, err := c.listProjectMembers(ctx, project)
+	if err != nil {
+		return errors.Wrapf(err, "list members of project %s", project)
+	}
+	for _, member := range members {
+		access := projectAccess(project, member)
+		if user, ok := users[value(member.Slug)]; ok && value(member.Type) == doppler.MemberTypeWorkplaceUser {
+			user.Projects = append(user.Projects, &access)
+			continue
+		}
+		report.Members = append(report.Members, &ReportMember{
+			Type:          value(member.Type),
+			Slug:          value(member.Slug),
+			ProjectAccess: access,
+		})
+	}
+
+	configs, err := c.listConfigs(ctx, project)
+	if err != nil {
+		return errors.Wrapf(err, "list configs of project %s", project)
+	}
+	for _, config := range configs {
+		tokens, err := c.listServiceTokens(ctx, project, value(config.Name))
+		if err != nil {
+			return errors.Wrapf(err, "list service tokens of config %s/%s", project, value(config.Name))
+		}
+		for _, token := range tokens {
+			report.ServiceTokens = append(report.ServiceTokens, &ReportServiceToken{
+				Name:        value(token.Name),
+				Slug:        value(token.Slug),
+				Project:     project,
+				Environment: value(token.Environment),
+				Config:      value(config.Name),
+				Access:      value(token.Access),
+				CreatedAt:   value(token.CreatedAt),
+				ExpiresAt:   value(token.ExpiresAt),
+				LastSeenAt:  value(token.LastSeenAt),
+			})
+		}
+	}
+
+	return nil
+}
+
+func (c Client) report(ctx context.Context) (*Report, error) {
+	report := &Report{GeneratedAt: time.Now().UTC()}
+
+	workplace, _, err := c.workplaceGet(ctx, &doppler.AuditWorkplaceGetOptions{Settings: pointer.To(true)})
+	if err != nil {
+		return nil, errors.Wrap(err, "get workplace")
+	}
+	if workplace != nil {
+		report.Workplace = ReportWorkplace{
+			ID:          value(workplace.ID),
+			Name:        value(workplace.Name),
+			SAMLEnabled: workplace.SAMLEnabled != nil && *workplace.SAMLEnabled,
+			SCIMEnabled: workplace.SCIMEnabled != nil && *workplace.SCIMEnabled,
+		}
+	}
+
+	workplaceUsers, _, err := c.workplaceUserList(ctx, &doppler.AuditWorkplaceUserListOptions{Settings: pointer.To(true)})
+	if err != nil {
+		return nil, errors.Wrap(err, "list workplace users")
+	}
+	users := make(map[string]*ReportUser, len(workplaceUsers))
+	for _, workplaceUser := range workplaceUsers {
+		user := &ReportUser{
+			ID:      value(workplaceUser.ID),
+			Access:  value(workplaceUser.Access),
+			AddedAt: value(workplaceUser.CreatedAt),
+		}
+		if workplaceUser.User != nil {
+			user.Email = value(workplaceUser.User.Email)
+			user.Name = value(workplaceUser.User.Name)
+		}
+		users[user.ID] = user
+		report.Users = append(report.Users, user)
+	}
+
+	projects, err := c.listProjects(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "list projects")
+	}
+	for _, project := range projects {
+		slug := value(project.Slug)
+		if slug == "" {
+			slug = value(project.ID)
+		}
+		if err := c.projectReport(ctx, report, users, slug); err != nil {
+			return nil, err
+		}
+	}
+
+	report.sort()
+
+	return report, nil
+}
+
+// sort sorts the entries of the report, so that reports of the same state are equal.
+func (r *Report) sort() {
+	sort.Slice(r.Users, func(i, j int) bool {
+		if r.Users[i].Email != r.Users[j].Email {
+			return r.Users[i].Email < r.Users[j].Email
+		}
+		return r.Users[i].ID < r.Users[j].ID
+	})
+	for _, user := range r.Users {
+		sort.Slice(user.Projects, func(i, j int) bool { return user.Projects[i].Project < user.Projects[j].Project })
+	}
+	sort.Slice(r.Members, func(i, j int) bool {
+		a, b := r.Members[i], r.Members[j]
+		if a.Project != b.Project {
+			return a.Project < b.Project
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Slug < b.Slug
+	})
+	sort.Slice(r.ServiceTokens, func(i, j int) bool {
+		a, b := r.ServiceTokens[i], r.ServiceTokens[j]
+		if a.Project != b.Project {
+			return a.Project < b.Project
+		}
+		if a.Config != b.Config {
+			return a.Config < b.Config
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Slug < b.Slug
+	})
+}
+
+// GenerateReport generates an access review report of the workplace. It combines the workplace users and their access levels,
+// the workplace's SAML and SCIM status, the members of every project and the service tokens of every config.
+func (c Client) GenerateReport(ctx context.Context) (*Report, error) {
+	return c.report(ctx)
+}
+
+// GenerateReport generates an access review report of the workplace using the default client.
+func GenerateReport(ctx context.Context) (*Report, error) {
+	return Default().GenerateReport(ctx)
+}
@@ -0,0 +1,293 @@
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/audit"
+	"github.com/nikoksr/doppler-go/internal/mockapi"
+	"github.com/nikoksr/doppler-go/pointer"
+)
+
+// newWorkplaceServer returns a server mocking the endpoints an access review report is generated from.
+func newWorkplaceServer(t *testing.T) *mockapi.Server {
+	projects := []*doppler.Project{
+		{ID: pointer.To("frontend"), Slug: pointer.To("frontend")},
+		{ID: pointer.To("backend"), Slug: pointer.To("backend")},
+	}
+	members := map[string][]*doppler.ProjectMember{
+		"backend": {
+			{Type: pointer.To("workplace_user"), Slug: pointer.To("u2"), Role: &doppler.ProjectMemberRole{Identifier: pointer.To("viewer")}, AccessAllEnvironments: pointer.To(false), Environments: []string{"stg", "dev"}},
+			{Type: pointer.To("group"), Slug: pointer.To("ops"), Role: &doppler.ProjectMemberRole{Identifier: pointer.To("admin")}, AccessAllEnvironments: pointer.To(true)},
+		},
+		"frontend": {
+			{Type: pointer.To("workplace_user"), Slug: pointer.To("u2"), Role: &doppler.ProjectMemberRole{Identifier: pointer.To("collaborator")}, AccessAllEnvironments: pointer.To(true)},
+			{Type: pointer.To("service_account"), Slug: pointer.To("ci"), Role: &doppler.ProjectMemberRole{Identifier: pointer.To("viewer")}, AccessAllEnvironments: pointer.To(false), Environments: []string{"prd"}},
+		},
+	}
+	configs := map[string][]*doppler.Config{
+		"backend":  {{Name: pointer.To("prd")}, {Name: pointer.To("dev")}},
+		"frontend": {{Name: pointer.To("prd")}},
+	}
+	tokens := map[string][]*doppler.ServiceToken{
+		"backend/prd": {
+			{Name: pointer.To("deploy"), Slug: pointer.To("t1"), Environment: pointer.To("prd"), Access: pointer.To("read"), CreatedAt: pointer.To("2023-03-01T00:00:00Z"), LastSeenAt: pointer.To("2023-03-30T00:00:00Z")},
+		},
+		"frontend/prd": {
+			{Name: pointer.To("cdn"), Slug: pointer.To("t2"), Environment: pointer.To("prd"), Access: pointer.To("read/write"), CreatedAt: pointer.To("2023-03-02T00:00:00Z"), ExpiresAt: pointer.To("2023-06-01T00:00:00Z")},
+		},
+	}
+
+	return mockapi.New(t, map[string]mockapi.Route{
+		"/v3/workplace": func(*http.Request) any {
+			return &doppler.AuditWorkplaceGetResponse{APIResponse: mockapi.OK(), AuditWorkplace: &doppler.AuditWorkplace{
+				ID:          pointer.To("wp1"),
+				Name:        pointer.To("Acme | Inc"),
+				SAMLEnabled: pointer.To(true),
+				SCIMEnabled: pointer.To(false),
+			}}
+		},
+		"/v3/workplace/users": func(*http.Request) any {
+			return &doppler.AuditWorkplaceUserListResponse{APIResponse: mockapi.OK(), AuditWorkplaceUsers: []*doppler.AuditWorkplaceUser{
+				{ID: pointer.To("u2"), Access: pointer.To("collaborator"), User: &doppler.User{Email: pointer.To("joe@example.com"), Name: pointer.To("Joe")}, CreatedAt: pointer.To("2023-02-01T00:00:00Z")},
+				{ID: pointer.To("u1"), Access: pointer.To("owner"), User: &doppler.User{Email: pointer.To("jane@example.com"), Name: pointer.To("Jane")}, CreatedAt: pointer.To("2023-01-01T00:00:00Z")},
+			}}
+		},
+		"/v3/projects": func(r *http.Request) any {
+			return &doppler.ProjectListResponse{APIResponse: mockapi.OK(), Projects: mockapi.Page(r, projects)}
+		},
+		"/v3/projects/project/members": func(r *http.Request) any {
+			return &doppler.ProjectMemberListResponse{APIResponse: mockapi.OK(), Members: mockapi.Page(r, members[r.URL.Query().Get("project")])}
+		},
+		"/v3/configs": func(r *http.Request) any {
+			return &doppler.ConfigListResponse{APIResponse: mockapi.OK(), Configs: mockapi.Page(r, configs[r.URL.Query().Get("project")])}
+		},
+		"/v3/configs/config/tokens": func(r *http.Request) any {
+			query := r.URL.Query()
+			return &doppler.ServiceTokenListResponse{APIResponse: mockapi.OK(), Tokens: tokens[query.Get("project")+"/"+query.Get("config")]}
+		},
+	})
+}
+
+// testReport returns the report generated from newWorkplaceServer.
+func testReport() *audit.Report {
+	return &audit.Report{
+		GeneratedAt: time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC),
+		Workplace:   audit.ReportWorkplace{ID: "wp1", Name: "Acme | Inc", SAMLEnabled: true},
+		Users: []*audit.ReportUser{
+			{ID: "u1", Email: "jane@example.com", Name: "Jane", Access: "owner", AddedAt: "2023-01-01T00:00:00Z"},
+			{ID: "u2", Email: "joe@example.com", Name: "Joe", Access: "collaborator", AddedAt: "2023-02-01T00:00:00Z", Projects: []*audit.ProjectAccess{
+				{Project: "backend", Role: "viewer", Environments: []string{"dev", "stg"}},
+				{Project: "frontend", Role: "collaborator", AllEnvironments: true},
+			}},
+		},
+		Members: []*audit.ReportMember{
+			{Type: "group", Slug: "ops", ProjectAccess: audit.ProjectAccess{Project: "backend", Role: "admin", AllEnvironments: true}},
+			{Type: "service_account", Slug: "ci", ProjectAccess: audit.ProjectAccess{Project: "frontend", Role: "viewer", Environments: []string{"prd"}}},
+		},
+		ServiceTokens: []*audit.ReportServiceToken{
+			{Name: "deploy", Slug: "t1", Project: "backend", Environment: "prd", Config: "prd", Access: "read", CreatedAt: "2023-03-01T00:00:00Z", LastSeenAt: "2023-03-30T00:00:00Z"},
+			{Name: "cdn", Slug: "t2", Project: "frontend", Environment: "prd", Config: "prd", Access: "read/write", CreatedAt: "2023-03-02T00:00:00Z", ExpiresAt: "2023-06-01T00:00:00Z"},
+		},
+	}
+}
+
+func TestAudit_GenerateReport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		failPath   string
+		wantReport *audit.Report
+		wantErr    bool
+	}{
+		{
+			name:       "Generate report",
+			wantReport: testReport(),
+		},
+		{
+			name:     "Generate report with failing member list",
+			failPath: "/v3/projects/project/members",
+			wantErr:  true,
+		},
+		{
+			name:     "Generate report with failing token list",
+			failPath: "/v3/configs/config/tokens",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newWorkplaceServer(t)
+			srv.Fail(tt.failPath)
+			client := &audit.Client{Backend: srv.Backend(), Key: "test"}
+
+			gotReport, err := client.GenerateReport(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unexpected error. Expected %t, got %v", tt.wantErr, err)
+			}
+			if diff := cmp.Diff(tt.wantReport, gotReport, cmpopts.IgnoreFields(audit.Report{}, "GeneratedAt")); diff != "" {
+				t.Errorf("Unexpected report (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReport_WriteJSON(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := testReport().WriteJSON(&buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var got audit.Report
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("Failed to decode report: %v", err)
+	}
+	if diff := cmp.Diff(testReport(), &got); diff != "" {
+		t.Errorf("Unexpected report (-want +got):\n%s", diff)
+	}
+}
+
+func TestReport_WriteCSV(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := testReport().WriteCSV(&buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := `principal_type,principal,name,scope,access,environments,expires_at,last_seen_at
+workplace_user,jane@example.com,Jane,workplace,owner,,,
+workplace_user,joe@example.com,Joe,workplace,collaborator,,,
+workplace_user,joe@example.com,Joe,backend,viewer,"dev,stg",,
+workplace_user,joe@example.com,Joe,frontend,collaborator,all,,
+group,ops,,backend,admin,all,,
+service_account,ci,,frontend,viewer,prd,,
+service_token,t1,deploy,backend/prd,read,,,2023-03-30T00:00:00Z
+service_token,t2,cdn,frontend/prd,read/write,,2023-06-01T00:00:00Z,
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("Unexpected CSV (-want +got):\n%s", diff)
+	}
+}
+
+func TestReport_WriteMarkdown(t *testing.T) {
+	t.Parallel()
+
+	report := testReport()
+	report.Members = nil
+
+	var buf bytes.Buffer
+	if err := report.WriteMarkdown(&buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := `# Access review: Acme \| Inc
+
+Generated at 2023-04-01T00:00:00Z. SAML is enabled, SCIM is disabled.
+
+## Users
+
+| Email | Name | Workplace access | Added | Projects |
+| --- | --- | --- | --- | --- |
+| jane@example.com | Jane | owner | 2023-01-01T00:00:00Z | - |
+| joe@example.com | Joe | collaborator | 2023-02-01T00:00:00Z | backend (viewer: dev,stg), frontend (collaborator: all) |
+
+## Project members
+
+None.
+
+## Service tokens
+
+| Project | Config | Name | Access | Created | Expires | Last used |
+| --- | --- | --- | --- | --- | --- | --- |
+| backend | prd | deploy | read | 2023-03-01T00:00:00Z | never | 2023-03-30T00:00:00Z |
+| frontend | prd | cdn | read/write | 2023-03-02T00:00:00Z | 2023-06-01T00:00:00Z | - |
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("Unexpected markdown (-want +got):\n%s", diff)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	from := testReport()
+	to := testReport()
+
+	// Jane leaves, Joe gets access to all backend environments, the ops group gets a new project and the deploy token
+	// is used again, which isn't an access change.
+	to.Users = to.Users[1:]
+	to.Users[0].Projects[0] = &audit.ProjectAccess{Project: "backend", Role: "viewer", AllEnvironments: true}
+	to.Members = append(to.Members, &audit.ReportMember{Type: "group", Slug: "ops", ProjectAccess: audit.ProjectAccess{Project: "frontend", Role: "viewer", AllEnvironments: true}})
+	to.ServiceTokens[0].LastSeenAt = "2023-06-30T00:00:00Z"
+
+	diff := audit.Diff(from, to)
+
+	want := &audit.ReportDiff{
+		Added: []audit.Grant{
+			{PrincipalType: "group", Principal: "ops", Scope: "frontend", Access: "viewer", Environments: "all"},
+		},
+		Removed: []audit.Grant{
+			{PrincipalType: "workplace_user", Principal: "jane@example.com", Name: "Jane", Scope: "workplace", Access: "owner"},
+		},
+		Changed: []audit.GrantChange{
+			{
+				Before: audit.Grant{PrincipalType: "workplace_user", Principal: "joe@example.com", Name: "Joe", Scope: "backend", Access: "viewer", Environments: "dev,stg"},
+				After:  audit.Grant{PrincipalType: "workplace_user", Principal: "joe@example.com", Name: "Joe", Scope: "backend", Access: "viewer", Environments: "all"},
+			},
+		},
+	}
+	if d := cmp.Diff(want, diff); d != "" {
+		t.Errorf("Unexpected diff (-want +got):\n%s", d)
+	}
+	if got := diff.String(); got != "1 grants added, 1 removed, 1 changed" {
+		t.Errorf("Unexpected summary %q", got)
+	}
+
+	var buf bytes.Buffer
+	if err := diff.WriteMarkdown(&buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	wantMarkdown := `# Access changes
+
+## Granted
+
+| Type | Principal | Scope | Access |
+| --- | --- | --- | --- |
+| group | ops | frontend | viewer (all) |
+
+## Revoked
+
+| Type | Principal | Scope | Access |
+| --- | --- | --- | --- |
+| workplace_user | jane@example.com | workplace | owner |
+
+## Changed
+
+| Type | Principal | Scope | Before | After |
+| --- | --- | --- | --- | --- |
+| workplace_user | joe@example.com | backend | viewer (dev,stg) | viewer (all) |
+`
+	if d := cmp.Diff(wantMarkdown, buf.String()); d != "" {
+		t.Errorf("Unexpected markdown (-want +got):\n%s", d)
+	}
+
+	if !audit.Diff(from, testReport()).Empty() {
+		t.Error("Expected no changes between equal reports")
+	}
+}
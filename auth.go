@@ -8,7 +8,7 @@ type (
 
 	// AuthToken is the object representing an auth token.
 	AuthToken struct {
-		Token *string `url:"-" json:"token,omitempty"` // The token itself.
+		Token *string `url:"-" json:"token,omitempty" validate:"required"` // The token itself.
 	}
 
 	// AuthRevokeResponse is the response from the AuthRevokeOptions endpoint.
@@ -22,7 +22,42 @@ type (
 
 	// AuthRevokeOptions revokes an auth token.
 	AuthRevokeOptions struct {
-		Tokens []AuthToken `url:"-" json:"tokens"` // A list of tokens to revoke.
+		Tokens []AuthToken `url:"-" json:"tokens" validate:"required,min=1,dive"` // A list of tokens to revoke.
+	}
+
+	// AuthTokenInfo is the object representing the information that can be derived from a token without calling the
+	// API.
+	AuthTokenInfo struct {
+		Type    TokenType `json:"type"`    // The type of the token.
+		Prefix  string    `json:"prefix"`  // The prefix of the token, e.g. "dp.st.". Empty for unknown token types.
+		Preview string    `json:"preview"` // A redacted version of the token that is safe to display.
+	}
+
+	// AuthMeWorkplace is the object representing the workplace a token belongs to.
+	AuthMeWorkplace struct {
+		Slug *string `json:"slug,omitempty"` // The unique identifier of the workplace.
+		Name *string `json:"name,omitempty"` // The name of the workplace.
+	}
+
+	// AuthMe is the object representing the identity a token acts as.
+	AuthMe struct {
+		Slug         *string          `json:"slug,omitempty"`          // The unique identifier of the token.
+		Name         *string          `json:"name,omitempty"`          // The name of the token.
+		Type         *string          `json:"type,omitempty"`          // The type of the token, as reported by the API.
+		TokenPreview *string          `json:"token_preview,omitempty"` // A redacted version of the token.
+		Workplace    *AuthMeWorkplace `json:"workplace,omitempty"`     // The workplace the token belongs to.
+		CreatedAt    *string          `json:"created_at,omitempty"`    // Date and time of the token's creation.
+		LastSeenAt   *string          `json:"last_seen_at,omitempty"`  // Date and time of the token's last use.
+	}
+
+	// AuthMeResponse is the response from the me endpoint.
+	//
+	// Method:    GET
+	// Endpoint:  https://api.doppler.com/v3/me
+	// Docs:      https://docs.doppler.com/reference/auth-me
+	AuthMeResponse struct {
+		APIResponse `json:",inline"`
+		AuthMe      `json:",inline"`
 	}
 )
 
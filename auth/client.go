@@ -42,3 +42,28 @@ func (c Client) Revoke(ctx context.Context, opts *doppler.AuthRevokeOptions) (do
 func Revoke(ctx context.Context, opts *doppler.AuthRevokeOptions) (doppler.APIResponse, error) {
 	return Default().Revoke(ctx, opts)
 }
+
+func (c Client) me(ctx context.Context) (*doppler.AuthMe, doppler.APIResponse, error) {
+	var resp doppler.AuthMeResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method: http.MethodGet,
+		Path:   "/v3/me",
+		Key:    c.Key,
+	}, &resp)
+	if err != nil {
+		return nil, resp.APIResponse, err
+	}
+
+	return &resp.AuthMe, resp.APIResponse, nil
+}
+
+// Me returns information about the token used by the client, including its type and the workplace it acts in.
+func (c Client) Me(ctx context.Context) (*doppler.AuthMe, doppler.APIResponse, error) {
+	return c.me(ctx)
+}
+
+// Me returns information about the token used by the client, including its type and the workplace it acts in, using
+// the default client.
+func Me(ctx context.Context) (*doppler.AuthMe, doppler.APIResponse, error) {
+	return Default().Me(ctx)
+}
@@ -121,3 +121,93 @@ func TestAuth_Revoke(t *testing.T) {
 		})
 	}
 }
+
+func TestAuth_Me(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		wantMe       *doppler.AuthMe
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "Get me",
+			wantMe: &doppler.AuthMe{
+				Slug:         pointer.To("token-slug"),
+				Name:         pointer.To("ci"),
+				Type:         pointer.To("service_account"),
+				TokenPreview: pointer.To("dp.sa.abcd…"),
+				Workplace: &doppler.AuthMeWorkplace{
+					Slug: pointer.To("workplace-slug"),
+					Name: pointer.To("Workplace"),
+				},
+				CreatedAt:  pointer.To("2020-01-01T00:00:00.000Z"),
+				LastSeenAt: pointer.To("2020-01-02T00:00:00.000Z"),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:   "Get me with invalid token",
+			wantMe: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "401 Unauthorized",
+				StatusCode: http.StatusUnauthorized,
+				Messages:   []string{"Invalid Auth token"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v3/me" {
+					t.Errorf("Unexpected path %q", r.URL.Path)
+				}
+
+				resp := &doppler.AuthMeResponse{APIResponse: tt.wantResponse}
+				if tt.wantMe != nil {
+					resp.AuthMe = *tt.wantMe
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &auth.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotMe, gotResponse, err := client.Me(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			if diff := cmp.Diff(tt.wantMe, gotMe); diff != "" {
+				t.Errorf("Unexpected me (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
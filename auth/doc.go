@@ -12,5 +12,13 @@ Example:
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	// Show who the default client is acting as.
+	me, _, err := auth.Me(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("Acting as %s (%s) in %s\n", *me.Name, *me.Type, *me.Workplace.Name)
 */
 package auth
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/nikoksr/doppler-go"
+)
+
+// previewLength is the number of characters after the prefix that are kept in a token preview.
+const previewLength = 4
+
+// ErrInvalidToken is returned by ParseToken if the given token is malformed.
+var ErrInvalidToken = errors.New("invalid token")
+
+// ParseToken classifies the given token by its prefix. It doesn't call the API, so it can't tell whether the token is
+// actually valid; use Client.Me for that. Tokens without a known prefix are classified as doppler.TokenTypeUnknown,
+// since older Doppler tokens didn't carry one. An error is only returned for tokens that can't be valid at all.
+func ParseToken(token string) (*doppler.AuthTokenInfo, error) {
+	if token == "" {
+		return nil, errors.Wrap(ErrInvalidToken, "token is empty")
+	}
+	if strings.ContainsAny(token, " \t\r\n") {
+		return nil, errors.Wrap(ErrInvalidToken, "token contains whitespace")
+	}
+
+	tokenType := doppler.TokenTypeOf(token)
+	prefix := doppler.TokenPrefix(tokenType)
+	secret := strings.TrimPrefix(token, prefix)
+	if secret == "" {
+		return nil, errors.Wrapf(ErrInvalidToken, "%s token has no content after its prefix", tokenType)
+	}
+
+	// Only keep the first few characters after the prefix.
+	preview := prefix
+	if len(secret) > previewLength {
+		preview += secret[:previewLength]
+	}
+	preview += "…"
+
+	return &doppler.AuthTokenInfo{
+		Type:    tokenType,
+		Prefix:  prefix,
+		Preview: preview,
+	}, nil
+}
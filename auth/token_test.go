@@ -0,0 +1,84 @@
+package auth_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/auth"
+)
+
+func TestParseToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		token   string
+		want    *doppler.AuthTokenInfo
+		wantErr bool
+	}{
+		{
+			name:  "Personal token",
+			token: "dp.pt.abcdefgh",
+			want:  &doppler.AuthTokenInfo{Type: doppler.TokenTypePersonal, Prefix: "dp.pt.", Preview: "dp.pt.abcd…"},
+		},
+		{
+			name:  "Service token",
+			token: "dp.st.dev.abcdefgh",
+			want:  &doppler.AuthTokenInfo{Type: doppler.TokenTypeService, Prefix: "dp.st.", Preview: "dp.st.dev.…"},
+		},
+		{
+			name:  "Service account token",
+			token: "dp.sa.abcdefgh",
+			want:  &doppler.AuthTokenInfo{Type: doppler.TokenTypeServiceAccount, Prefix: "dp.sa.", Preview: "dp.sa.abcd…"},
+		},
+		{
+			name:  "CLI token",
+			token: "dp.ct.abcdefgh",
+			want:  &doppler.AuthTokenInfo{Type: doppler.TokenTypeCLI, Prefix: "dp.ct.", Preview: "dp.ct.abcd…"},
+		},
+		{
+			name:  "SCIM token",
+			token: "dp.scim.abcdefgh",
+			want:  &doppler.AuthTokenInfo{Type: doppler.TokenTypeSCIM, Prefix: "dp.scim.", Preview: "dp.scim.abcd…"},
+		},
+		{
+			name:  "Audit token",
+			token: "dp.audit.abcdefgh",
+			want:  &doppler.AuthTokenInfo{Type: doppler.TokenTypeAudit, Prefix: "dp.audit.", Preview: "dp.audit.abcd…"},
+		},
+		{
+			name:  "Short token",
+			token: "dp.st.ab",
+			want:  &doppler.AuthTokenInfo{Type: doppler.TokenTypeService, Prefix: "dp.st.", Preview: "dp.st.…"},
+		},
+		{
+			name:  "Legacy token",
+			token: "abcdefgh",
+			want:  &doppler.AuthTokenInfo{Type: doppler.TokenTypeUnknown, Prefix: "", Preview: "abcd…"},
+		},
+		{name: "Empty token", token: "", wantErr: true},
+		{name: "Prefix only", token: "dp.st.", wantErr: true},
+		{name: "Whitespace", token: "dp.st.abc def", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := auth.ParseToken(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, auth.ErrInvalidToken) {
+				t.Errorf("ParseToken() error = %v, want %v", err, auth.ErrInvalidToken)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ParseToken() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
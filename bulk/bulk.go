@@ -0,0 +1,184 @@
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultWorkers is the number of targets processed concurrently if no number is given.
+const DefaultWorkers = 4
+
+// ErrSkipped is the error of targets that weren't processed because the batch was aborted.
+var ErrSkipped = errors.New("target skipped")
+
+type (
+	// Target identifies a config to run an operation on.
+	Target struct {
+		Project string // Identifier of the project.
+		Config  string // Name of the config.
+	}
+
+	// Result represents the outcome of an operation on a target.
+	Result[T any] struct {
+		Target Target // The target.
+		Value  T      // The value returned by the operation.
+		Err    error  // The error returned by the operation; ErrSkipped if the target wasn't processed.
+	}
+
+	// Progress represents the progress of a batch after a target was processed.
+	Progress struct {
+		Target Target // The processed target.
+		Err    error  // The error of the processed target.
+		Done   int    // Number of processed targets.
+		Failed int    // Number of failed targets.
+		Total  int    // Number of targets.
+	}
+
+	// Options represents the options for running a batch.
+	Options struct {
+		Workers    int            // Number of targets processed concurrently. Defaults to DefaultWorkers.
+		FailFast   bool           // Abort the batch on the first failed target; remaining targets are skipped.
+		Limiter    *Limiter       // If set, every target waits for the limiter; leave unset if the client's backend is limited.
+		OnProgress func(Progress) // Called after every processed target. Calls are serialized.
+	}
+
+	// TargetError represents the failure of an operation on a target.
+	TargetError struct {
+		Target Target // The failed target.
+		Err    error  // The error returned by the operation.
+	}
+
+	// Errors represents the failures of a batch, in the order of the targets.
+	Errors []*TargetError
+)
+
+// String returns the target as "project/config".
+func (t Target) String() string {
+	return t.Project + "/" + t.Config
+}
+
+// Error returns the target and its error.
+func (e *TargetError) Error() string {
+	return e.Target.String() + ": " + e.Err.Error()
+}
+
+// Unwrap returns the error returned by the operation.
+func (e *TargetError) Unwrap() error {
+	return e.Err
+}
+
+// Error summarizes the failures.
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return fmt.Sprintf("%d targets failed: %s", len(e), strings.Join(messages, "; "))
+}
+
+// Is reports whether any of the failures matches the target error.
+func (e Errors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Run runs the operation on all targets using a bounded pool of workers. The results are returned in the order of the
+// targets. A failed target doesn't abort the batch unless opts.FailFast is set; the failures are returned as Errors.
+// If the context is canceled, the remaining targets are skipped.
+func Run[T any](ctx context.Context, targets []Target, fn func(ctx context.Context, target Target) (T, error), opts *Options) ([]Result[T], error) {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	if o.Workers < 1 {
+		o.Workers = DefaultWorkers
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]Result[T], len(targets))
+	for i, target := range targets {
+		results[i] = Result[T]{Target: target, Err: ErrSkipped}
+	}
+
+	var (
+		mu       sync.Mutex
+		progress = Progress{Total: len(targets)}
+	)
+	report := func(i int, value T, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		results[i].Value, results[i].Err = value, err
+		progress.Target, progress.Err = targets[i], err
+		progress.Done++
+		if err != nil {
+			progress.Failed++
+			if o.FailFast {
+				cancel()
+			}
+		}
+		if o.OnProgress != nil {
+			o.OnProgress(progress)
+		}
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < o.Workers && w < len(targets); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if ctx.Err() != nil {
+					continue
+				}
+				if o.Limiter != nil {
+					if err := o.Limiter.Wait(ctx); err != nil {
+						continue
+					}
+				}
+				value, err := fn(ctx, targets[i])
+				if err != nil && ctx.Err() != nil && errors.Is(err, ctx.Err()) {
+					// The target was interrupted by the aborted batch, rather than failing on its own.
+					continue
+				}
+				report(i, value, err)
+			}
+		}()
+	}
+
+feed:
+	for i := range targets {
+		select {
+		case <-ctx.Done():
+			break feed
+		case indexes <- i:
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	var failed Errors
+	for _, result := range results {
+		if result.Err != nil && !errors.Is(result.Err, ErrSkipped) {
+			failed = append(failed, &TargetError{Target: result.Target, Err: result.Err})
+		}
+	}
+	if len(failed) > 0 {
+		return results, failed
+	}
+
+	return results, ctx.Err()
+}
@@ -0,0 +1,200 @@
+package bulk_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/nikoksr/doppler-go/bulk"
+)
+
+func testTargets(n int) []bulk.Target {
+	targets := make([]bulk.Target, n)
+	for i := range targets {
+		targets[i] = bulk.Target{Project: "backend", Config: fmt.Sprintf("cfg%d", i)}
+	}
+
+	return targets
+}
+
+var errFailed = errors.New("failed")
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		targets     int
+		failing     map[string]bool
+		opts        *bulk.Options
+		wantValues  []string
+		wantErrs    []error
+		wantFailed  []string
+		wantSkipped int
+	}{
+		{
+			name:       "All succeed",
+			targets:    10,
+			opts:       &bulk.Options{Workers: 3},
+			wantValues: []string{"cfg0", "cfg1", "cfg2", "cfg3", "cfg4", "cfg5", "cfg6", "cfg7", "cfg8", "cfg9"},
+			wantErrs:   make([]error, 10),
+		},
+		{
+			name:       "Failures don't abort the batch",
+			targets:    5,
+			failing:    map[string]bool{"cfg1": true, "cfg3": true},
+			opts:       &bulk.Options{Workers: 2},
+			wantValues: []string{"cfg0", "", "cfg2", "", "cfg4"},
+			wantErrs:   []error{nil, errFailed, nil, errFailed, nil},
+			wantFailed: []string{"backend/cfg1", "backend/cfg3"},
+		},
+		{
+			name:       "Fail fast skips remaining targets",
+			targets:    5,
+			failing:    map[string]bool{"cfg1": true},
+			opts:       &bulk.Options{Workers: 1, FailFast: true},
+			wantValues: []string{"cfg0", "", "", "", ""},
+			wantErrs:   []error{nil, errFailed, bulk.ErrSkipped, bulk.ErrSkipped, bulk.ErrSkipped},
+			wantFailed: []string{"backend/cfg1"},
+		},
+		{
+			name:       "Default options",
+			targets:    2,
+			wantValues: []string{"cfg0", "cfg1"},
+			wantErrs:   make([]error, 2),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			workers := bulk.DefaultWorkers
+			if tt.opts != nil {
+				workers = tt.opts.Workers
+			}
+
+			var running, maxRunning int32
+			var progress []bulk.Progress
+			opts := tt.opts
+			if opts != nil {
+				opts.OnProgress = func(p bulk.Progress) { progress = append(progress, p) }
+			}
+
+			results, err := bulk.Run(context.Background(), testTargets(tt.targets), func(ctx context.Context, target bulk.Target) (string, error) {
+				n := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+				for {
+					prev := atomic.LoadInt32(&maxRunning)
+					if n <= prev || atomic.CompareAndSwapInt32(&maxRunning, prev, n) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+
+				if tt.failing[target.Config] {
+					return "", errFailed
+				}
+				return target.Config, nil
+			}, opts)
+
+			var gotValues []string
+			var gotErrs []error
+			for i, result := range results {
+				if result.Target != testTargets(tt.targets)[i] {
+					t.Errorf("Unexpected target %v at %d", result.Target, i)
+				}
+				gotValues = append(gotValues, result.Value)
+				gotErrs = append(gotErrs, result.Err)
+			}
+			if diff := cmp.Diff(tt.wantValues, gotValues); diff != "" {
+				t.Errorf("Unexpected values (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantErrs, gotErrs, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Unexpected errors (-want +got):\n%s", diff)
+			}
+
+			var gotFailed []string
+			var failed bulk.Errors
+			if errors.As(err, &failed) {
+				for _, f := range failed {
+					gotFailed = append(gotFailed, f.Target.String())
+				}
+			} else if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantFailed, gotFailed); diff != "" {
+				t.Errorf("Unexpected failed targets (-want +got):\n%s", diff)
+			}
+			if len(tt.wantFailed) > 0 && !errors.Is(err, errFailed) {
+				t.Errorf("Expected batch error to wrap the target errors, got %v", err)
+			}
+
+			if maxRunning > int32(workers) {
+				t.Errorf("Expected at most %d concurrent targets, got %d", workers, maxRunning)
+			}
+
+			if opts != nil {
+				processed := tt.targets
+				for _, err := range tt.wantErrs {
+					if errors.Is(err, bulk.ErrSkipped) {
+						processed--
+					}
+				}
+				if len(progress) != processed {
+					t.Fatalf("Expected %d progress reports, got %d", processed, len(progress))
+				}
+				last := progress[len(progress)-1]
+				if last.Done != processed || last.Failed != len(tt.wantFailed) || last.Total != tt.targets {
+					t.Errorf("Unexpected final progress %+v", last)
+				}
+			}
+		})
+	}
+}
+
+func TestRun_Canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var once sync.Once
+
+	results, err := bulk.Run(ctx, testTargets(20), func(ctx context.Context, target bulk.Target) (int, error) {
+		once.Do(cancel)
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}, &bulk.Options{Workers: 2})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	for _, result := range results {
+		if !errors.Is(result.Err, bulk.ErrSkipped) {
+			t.Errorf("Expected %s to be skipped, got %v", result.Target, result.Err)
+		}
+	}
+}
+
+func TestRun_Limiter(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+	_, err := bulk.Run(context.Background(), testTargets(5), func(ctx context.Context, target bulk.Target) (struct{}, error) {
+		return struct{}{}, nil
+	}, &bulk.Options{Workers: 5, Limiter: bulk.NewLimiter(100, time.Second)})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Five targets are spaced by 10ms each; the first starts immediately.
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("Expected limiter to space the targets, batch took %s", elapsed)
+	}
+}
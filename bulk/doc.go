@@ -0,0 +1,31 @@
+/*
+Package bulk runs operations on many configs concurrently. A bounded pool of workers processes the targets, failures are
+collected per target and the results are returned in the order of the targets. A Limiter shared by all workers keeps the
+batch within the API's rate limit.
+
+Example:
+
+	// Share a limiter across all requests of the batch
+	limiter := bulk.NewLimiter(240, time.Minute)
+	client := &secret.Client{Backend: limiter.Backend(doppler.GetBackend()), Key: doppler.Key}
+
+	targets := []bulk.Target{{Project: "backend", Config: "dev"}, {Project: "backend", Config: "prd"}}
+	results, err := bulk.Run(ctx, targets, func(ctx context.Context, t bulk.Target) (map[string]*doppler.SecretValue, error) {
+		secrets, _, err := client.List(ctx, &doppler.SecretListOptions{Project: t.Project, Config: t.Config})
+		return secrets, err
+	}, &bulk.Options{
+		Workers:    8,
+		OnProgress: func(p bulk.Progress) { log.Printf("%d/%d done, %d failed", p.Done, p.Total, p.Failed) },
+	})
+	for _, result := range results {
+		if result.Err == nil {
+			fmt.Printf("%s: %d secrets\n", result.Target, len(result.Value))
+		}
+	}
+
+	var failed bulk.Errors
+	if errors.As(err, &failed) {
+		log.Printf("%d configs failed", len(failed))
+	}
+*/
+package bulk
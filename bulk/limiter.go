@@ -0,0 +1,160 @@
+package bulk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/nikoksr/doppler-go"
+)
+
+// Limiter spaces requests evenly and pauses them while the API's rate limit is exhausted. It's safe for concurrent use,
+// so a single limiter can be shared by all workers and clients.
+//
+// A limiter is used either per target, through Options.Limiter, or per request, through Backend. Don't combine both
+// for the same batch, or every target takes two slots.
+type Limiter struct {
+	interval time.Duration
+
+	mu    sync.Mutex
+	next  time.Time // Earliest time of the next request.
+	reset time.Time // Time the last observed exhausted rate limit resets.
+}
+
+// NewLimiter returns a limiter allowing the given number of requests per period, e.g. 240 requests per minute.
+func NewLimiter(limit int, per time.Duration) *Limiter {
+	l := &Limiter{}
+	if limit > 0 {
+		l.interval = per / time.Duration(limit)
+	}
+
+	return l
+}
+
+// Wait blocks until the next request may be sent, or until the context is done. If the context is done first, the
+// reserved slot is released for later requests.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	slot := l.next
+	if slot.Before(now) {
+		slot = now
+	}
+	l.next = slot.Add(l.interval)
+	l.mu.Unlock()
+
+	wait := time.Until(slot)
+	if wait <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.release(slot)
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// release gives back the given reserved slot. Slots reserved after it keep their time, so the next reservation takes
+// its place instead. Slots are never moved before an exhausted rate limit resets.
+func (l *Limiter) release(slot time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	next := l.next.Add(-l.interval)
+	if next.Before(slot) || next.Before(l.reset) {
+		return
+	}
+	l.next = next
+}
+
+// Observe pauses further requests until the rate limit resets, if it's exhausted.
+func (l *Limiter) Observe(rateLimit *doppler.RateLimit) {
+	if rateLimit == nil || rateLimit.Remaining > 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if rateLimit.Reset.After(l.reset) {
+		l.reset = rateLimit.Reset
+	}
+	if rateLimit.Reset.After(l.next) {
+		l.next = rateLimit.Reset
+	}
+}
+
+// Backend returns a backend that waits for the limiter before every request and observes the rate limit of every
+// response. Don't also pass the limiter to Run through Options.Limiter; see Limiter.
+func (l *Limiter) Backend(backend doppler.Backend) doppler.Backend {
+	return &limitedBackend{backend: backend, limiter: l}
+}
+
+// limitedBackend is a backend limited by a Limiter.
+type limitedBackend struct {
+	backend doppler.Backend
+	limiter *Limiter
+}
+
+// Compile-time check to ensure that limitedBackend implements the Backend interface.
+var _ doppler.Backend = (*limitedBackend)(nil)
+
+// observedResponse passes the rate limit of a response to the limiter before handing it to the wrapped response.
+type observedResponse struct {
+	doppler.Response
+	limiter *Limiter
+}
+
+// WithDetails observes the rate limit of the HTTP response and binds its details to the wrapped response.
+func (r *observedResponse) WithDetails(resp *http.Response) {
+	var details doppler.APIResponse
+	details.WithDetails(resp)
+	r.limiter.Observe(details.RateLimit)
+
+	r.Response.WithDetails(resp)
+}
+
+// UnmarshalJSON decodes the response body into the wrapped response.
+func (r *observedResponse) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, r.Response)
+}
+
+// Call waits for the limiter and sends the request.
+func (b *limitedBackend) Call(ctx context.Context, req *doppler.Request, resp doppler.Response) error {
+	if err := b.limiter.Wait(ctx); err != nil {
+		return err
+	}
+	if resp == nil || reflect.ValueOf(resp).IsNil() {
+		return b.backend.Call(ctx, req, resp)
+	}
+
+	return b.backend.Call(ctx, req, &observedResponse{Response: resp, limiter: b.limiter})
+}
+
+// CallRaw waits for the limiter and sends the request.
+func (b *limitedBackend) CallRaw(ctx context.Context, req *doppler.Request) (*http.Response, error) {
+	if err := b.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := b.backend.CallRaw(ctx, req)
+	if resp != nil {
+		var details doppler.APIResponse
+		details.WithDetails(resp)
+		b.limiter.Observe(details.RateLimit)
+	}
+
+	return resp, err
+}
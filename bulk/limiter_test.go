@@ -0,0 +1,137 @@
+package bulk_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/bulk"
+	"github.com/nikoksr/doppler-go/pointer"
+	"github.com/nikoksr/doppler-go/project"
+)
+
+func TestLimiter_Wait(t *testing.T) {
+	t.Parallel()
+
+	limiter := bulk.NewLimiter(100, time.Second)
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := limiter.Wait(context.Background()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("Expected requests to be spaced by 10ms, took %s", elapsed)
+	}
+
+	// An unlimited limiter doesn't wait.
+	unlimited := bulk.NewLimiter(0, time.Second)
+	start = time.Now()
+	for i := 0; i < 100; i++ {
+		if err := unlimited.Wait(context.Background()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("Expected unlimited limiter not to wait, took %s", elapsed)
+	}
+}
+
+func TestLimiter_WaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	limiter := bulk.NewLimiter(10, time.Second)
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// A canceled wait releases its slot, so the next request takes it instead of queueing behind it.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected wait to be canceled, got %v", err)
+	}
+
+	// An already canceled context doesn't reserve a slot at all.
+	if err := limiter.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected wait to be canceled, got %v", err)
+	}
+
+	start := time.Now()
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Errorf("Expected released slots to be reused, waited %s", elapsed)
+	}
+}
+
+func TestLimiter_Observe(t *testing.T) {
+	t.Parallel()
+
+	limiter := bulk.NewLimiter(0, time.Second)
+
+	// Remaining requests don't pause the limiter.
+	limiter.Observe(&doppler.RateLimit{Limit: 10, Remaining: 1, Reset: time.Now().Add(time.Hour)})
+	limiter.Observe(nil)
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	limiter.Observe(&doppler.RateLimit{Limit: 10, Remaining: 0, Reset: time.Now().Add(time.Hour)})
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected exhausted rate limit to block until the deadline, got %v", err)
+	}
+}
+
+func TestLimiter_Backend(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Limit", "240")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+		_ = json.NewEncoder(w).Encode(&doppler.ProjectGetResponse{
+			APIResponse: doppler.APIResponse{Success: pointer.To(true)},
+			Project:     &doppler.Project{Name: pointer.To("backend")},
+		})
+	}))
+	defer ts.Close()
+
+	limiter := bulk.NewLimiter(0, time.Second)
+	client := &project.Client{
+		Backend: limiter.Backend(doppler.GetBackendWithConfig(&doppler.BackendConfig{
+			URL: pointer.To(ts.URL),
+		})),
+		Key: "test",
+	}
+
+	gotProject, gotResponse, err := client.Get(context.Background(), &doppler.ProjectGetOptions{Name: "backend"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(&doppler.Project{Name: pointer.To("backend")}, gotProject); diff != "" {
+		t.Errorf("Unexpected project (-want +got):\n%s", diff)
+	}
+	if gotResponse.RateLimit == nil || gotResponse.RateLimit.Remaining != 0 {
+		t.Errorf("Expected rate limit details to be bound to the response, got %+v", gotResponse.RateLimit)
+	}
+
+	// The exhausted rate limit pauses further requests.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, _, err := client.Get(ctx, &doppler.ProjectGetOptions{Name: "backend"}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected request to wait for the rate limit reset, got %v", err)
+	}
+}
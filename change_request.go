@@ -0,0 +1,180 @@
+package doppler
+
+// ChangeRequestStatus is the status of a change request.
+type ChangeRequestStatus string
+
+const (
+	// ChangeRequestStatusOpen is the status of a change request that awaits review.
+	ChangeRequestStatusOpen ChangeRequestStatus = "open"
+
+	// ChangeRequestStatusApproved is the status of a change request that was approved but not applied yet.
+	ChangeRequestStatusApproved ChangeRequestStatus = "approved"
+
+	// ChangeRequestStatusRejected is the status of a change request that was rejected by a reviewer.
+	ChangeRequestStatusRejected ChangeRequestStatus = "rejected"
+
+	// ChangeRequestStatusApplied is the status of a change request whose secret edits were written to the config.
+	ChangeRequestStatusApplied ChangeRequestStatus = "applied"
+
+	// ChangeRequestStatusClosed is the status of a change request that was closed without being applied.
+	ChangeRequestStatusClosed ChangeRequestStatus = "closed"
+)
+
+// ReviewStatus is the status of a reviewer's review of a change request.
+type ReviewStatus string
+
+const (
+	// ReviewStatusPending is the status of a review that wasn't submitted yet.
+	ReviewStatusPending ReviewStatus = "pending"
+
+	// ReviewStatusApproved is the status of a review that approved the change request.
+	ReviewStatusApproved ReviewStatus = "approved"
+
+	// ReviewStatusRejected is the status of a review that rejected the change request.
+	ReviewStatusRejected ReviewStatus = "rejected"
+)
+
+type (
+	// ChangeRequestReviewer represents a reviewer of a change request.
+	ChangeRequestReviewer struct {
+		User       *User         `json:"user,omitempty"`        // The reviewing user.
+		Status     *ReviewStatus `json:"status,omitempty"`      // Status of the review.
+		Comment    *string       `json:"comment,omitempty"`     // Comment left with the review.
+		ReviewedAt *string       `json:"reviewed_at,omitempty"` // Date and time the review was submitted.
+	}
+
+	// ChangeRequest represents a proposed set of secret edits to a config that has to be reviewed before it's applied.
+	ChangeRequest struct {
+		Slug        *string                  `json:"slug,omitempty"`        // Unique identifier of the change request.
+		Title       *string                  `json:"title,omitempty"`       // Title of the change request.
+		Description *string                  `json:"description,omitempty"` // Description of the change request.
+		Project     *string                  `json:"project,omitempty"`     // Identifier of the project the change request belongs to.
+		Config      *string                  `json:"config,omitempty"`      // Name of the config the change request belongs to.
+		Status      *ChangeRequestStatus     `json:"status,omitempty"`      // Status of the change request.
+		Secrets     map[string]string        `json:"secrets,omitempty"`     // The proposed secret edits.
+		Author      *User                    `json:"author,omitempty"`      // The user that created the change request.
+		Reviewers   []*ChangeRequestReviewer `json:"reviewers,omitempty"`   // The reviewers of the change request.
+		CreatedAt   *string                  `json:"created_at,omitempty"`  // Date and time of the object's creation.
+		UpdatedAt   *string                  `json:"updated_at,omitempty"`  // Date and time of the last status change.
+		AppliedAt   *string                  `json:"applied_at,omitempty"`  // Date and time the change request was applied.
+	}
+
+	// ChangeRequestListResponse represents a response from the change request list endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/configs/config/change_requests
+	ChangeRequestListResponse struct {
+		APIResponse    `json:",inline"`
+		ChangeRequests []*ChangeRequest `json:"change_requests"`
+	}
+
+	// ChangeRequestListOptions represents the query parameters for a change request list request.
+	ChangeRequestListOptions struct {
+		ListOptions `url:",inline" json:"-"`
+		Project     string               `url:"project" json:"-" validate:"required"`                                                       // Identifier of the project.
+		Config      string               `url:"config" json:"-" validate:"required"`                                                        // Name of the config.
+		Status      *ChangeRequestStatus `url:"status,omitempty" json:"-" validate:"omitempty,oneof=open approved rejected applied closed"` // Only list change requests with the given status.
+	}
+
+	// ChangeRequestGetResponse represents a response from the change request get endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/configs/config/change_requests/change_request
+	ChangeRequestGetResponse struct {
+		APIResponse   `json:",inline"`
+		ChangeRequest *ChangeRequest `json:"change_request,omitempty"`
+	}
+
+	// ChangeRequestGetOptions represents the options for the change request get endpoint.
+	ChangeRequestGetOptions struct {
+		Project string `url:"project" json:"-" validate:"required"`        // Identifier of the project.
+		Config  string `url:"config" json:"-" validate:"required"`         // Name of the config.
+		Slug    string `url:"change_request" json:"-" validate:"required"` // Unique identifier of the change request.
+	}
+
+	// ChangeRequestCreateResponse represents a response from the change request create endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/configs/config/change_requests
+	ChangeRequestCreateResponse struct {
+		APIResponse   `json:",inline"`
+		ChangeRequest *ChangeRequest `json:"change_request,omitempty"`
+	}
+
+	// ChangeRequestCreateOptions represents the options for the change request create endpoint. The secret edits mirror
+	// SecretUpdateOptions.
+	ChangeRequestCreateOptions struct {
+		Project     string            `url:"-" json:"project" validate:"required"`                         // Identifier of the project.
+		Config      string            `url:"-" json:"config" validate:"required"`                          // Name of the config.
+		NewSecrets  map[string]string `url:"-" json:"secrets" validate:"required,min=1"`                   // The proposed secret edits.
+		Title       string            `url:"-" json:"title" validate:"required"`                           // Title of the change request.
+		Description *string           `url:"-" json:"description,omitempty"`                               // Description of the change request.
+		Reviewers   []string          `url:"-" json:"reviewers,omitempty" validate:"omitempty,dive,email"` // Email addresses of the users requested to review.
+	}
+
+	// ChangeRequestApproveResponse represents a response from the change request approve endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/configs/config/change_requests/change_request/approve
+	ChangeRequestApproveResponse struct {
+		APIResponse   `json:",inline"`
+		ChangeRequest *ChangeRequest `json:"change_request,omitempty"`
+	}
+
+	// ChangeRequestApproveOptions represents the options for the change request approve endpoint.
+	ChangeRequestApproveOptions struct {
+		Project string  `url:"project" json:"-" validate:"required"`        // Identifier of the project.
+		Config  string  `url:"config" json:"-" validate:"required"`         // Name of the config.
+		Slug    string  `url:"change_request" json:"-" validate:"required"` // Unique identifier of the change request.
+		Comment *string `url:"-" json:"comment,omitempty"`                  // Comment left with the review.
+	}
+
+	// ChangeRequestRejectResponse represents a response from the change request reject endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/configs/config/change_requests/change_request/reject
+	ChangeRequestRejectResponse struct {
+		APIResponse   `json:",inline"`
+		ChangeRequest *ChangeRequest `json:"change_request,omitempty"`
+	}
+
+	// ChangeRequestRejectOptions represents the options for the change request reject endpoint.
+	ChangeRequestRejectOptions struct {
+		Project string  `url:"project" json:"-" validate:"required"`        // Identifier of the project.
+		Config  string  `url:"config" json:"-" validate:"required"`         // Name of the config.
+		Slug    string  `url:"change_request" json:"-" validate:"required"` // Unique identifier of the change request.
+		Comment *string `url:"-" json:"comment,omitempty"`                  // Comment left with the review.
+	}
+
+	// ChangeRequestApplyResponse represents a response from the change request apply endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/configs/config/change_requests/change_request/apply
+	ChangeRequestApplyResponse struct {
+		APIResponse   `json:",inline"`
+		ChangeRequest *ChangeRequest `json:"change_request,omitempty"`
+	}
+
+	// ChangeRequestApplyOptions represents the options for the change request apply endpoint.
+	ChangeRequestApplyOptions struct {
+		Project string `url:"project" json:"-" validate:"required"`        // Identifier of the project.
+		Config  string `url:"config" json:"-" validate:"required"`         // Name of the config.
+		Slug    string `url:"change_request" json:"-" validate:"required"` // Unique identifier of the change request.
+	}
+
+	// ChangeRequestCloseResponse represents a response from the change request close endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/configs/config/change_requests/change_request/close
+	ChangeRequestCloseResponse struct {
+		APIResponse   `json:",inline"`
+		ChangeRequest *ChangeRequest `json:"change_request,omitempty"`
+	}
+
+	// ChangeRequestCloseOptions represents the options for the change request close endpoint.
+	ChangeRequestCloseOptions struct {
+		Project string `url:"project" json:"-" validate:"required"`        // Identifier of the project.
+		Config  string `url:"config" json:"-" validate:"required"`         // Name of the config.
+		Slug    string `url:"change_request" json:"-" validate:"required"` // Unique identifier of the change request.
+	}
+)
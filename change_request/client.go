@@ -0,0 +1,176 @@
+package changerequest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/nikoksr/doppler-go"
+)
+
+// Client is the client used to invoke /v3/configs/config/change_requests APIs.
+type Client struct {
+	Backend doppler.Backend
+	Key     string
+}
+
+// Default returns a new client based on the SDK's default backend and API key.
+func Default() *Client {
+	return &Client{
+		Backend: doppler.GetBackend(),
+		Key:     doppler.Key,
+	}
+}
+
+func (c Client) list(ctx context.Context, opts *doppler.ChangeRequestListOptions) ([]*doppler.ChangeRequest, doppler.APIResponse, error) {
+	var resp doppler.ChangeRequestListResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    "/v3/configs/config/change_requests",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.ChangeRequests, resp.APIResponse, err
+}
+
+// List returns a page of a config's change requests.
+func (c Client) List(ctx context.Context, opts *doppler.ChangeRequestListOptions) ([]*doppler.ChangeRequest, doppler.APIResponse, error) {
+	return c.list(ctx, opts)
+}
+
+// List returns a page of a config's change requests using the default client.
+func List(ctx context.Context, opts *doppler.ChangeRequestListOptions) ([]*doppler.ChangeRequest, doppler.APIResponse, error) {
+	return Default().List(ctx, opts)
+}
+
+func (c Client) get(ctx context.Context, opts *doppler.ChangeRequestGetOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	var resp doppler.ChangeRequestGetResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    "/v3/configs/config/change_requests/change_request",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.ChangeRequest, resp.APIResponse, err
+}
+
+// Get returns a change request and its reviews.
+func (c Client) Get(ctx context.Context, opts *doppler.ChangeRequestGetOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	return c.get(ctx, opts)
+}
+
+// Get returns a change request and its reviews using the default client.
+func Get(ctx context.Context, opts *doppler.ChangeRequestGetOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	return Default().Get(ctx, opts)
+}
+
+func (c Client) create(ctx context.Context, opts *doppler.ChangeRequestCreateOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	var resp doppler.ChangeRequestCreateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/configs/config/change_requests",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.ChangeRequest, resp.APIResponse, err
+}
+
+// Create proposes secret edits to a config. The edits are only written once the change request is approved and applied.
+func (c Client) Create(ctx context.Context, opts *doppler.ChangeRequestCreateOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	return c.create(ctx, opts)
+}
+
+// Create proposes secret edits to a config. The edits are only written once the change request is approved and applied using the default client.
+func Create(ctx context.Context, opts *doppler.ChangeRequestCreateOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	return Default().Create(ctx, opts)
+}
+
+func (c Client) approve(ctx context.Context, opts *doppler.ChangeRequestApproveOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	var resp doppler.ChangeRequestApproveResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/configs/config/change_requests/change_request/approve",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.ChangeRequest, resp.APIResponse, err
+}
+
+// Approve approves a change request as the calling user.
+func (c Client) Approve(ctx context.Context, opts *doppler.ChangeRequestApproveOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	return c.approve(ctx, opts)
+}
+
+// Approve approves a change request as the calling user using the default client.
+func Approve(ctx context.Context, opts *doppler.ChangeRequestApproveOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	return Default().Approve(ctx, opts)
+}
+
+func (c Client) reject(ctx context.Context, opts *doppler.ChangeRequestRejectOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	var resp doppler.ChangeRequestRejectResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/configs/config/change_requests/change_request/reject",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.ChangeRequest, resp.APIResponse, err
+}
+
+// Reject rejects a change request as the calling user.
+func (c Client) Reject(ctx context.Context, opts *doppler.ChangeRequestRejectOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	return c.reject(ctx, opts)
+}
+
+// Reject rejects a change request as the calling user using the default client.
+func Reject(ctx context.Context, opts *doppler.ChangeRequestRejectOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	return Default().Reject(ctx, opts)
+}
+
+func (c Client) apply(ctx context.Context, opts *doppler.ChangeRequestApplyOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	var resp doppler.ChangeRequestApplyResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/configs/config/change_requests/change_request/apply",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.ChangeRequest, resp.APIResponse, err
+}
+
+// Apply writes the secret edits of an approved change request to its config.
+func (c Client) Apply(ctx context.Context, opts *doppler.ChangeRequestApplyOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	return c.apply(ctx, opts)
+}
+
+// Apply writes the secret edits of an approved change request to its config using the default client.
+func Apply(ctx context.Context, opts *doppler.ChangeRequestApplyOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	return Default().Apply(ctx, opts)
+}
+
+func (c Client) close(ctx context.Context, opts *doppler.ChangeRequestCloseOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	var resp doppler.ChangeRequestCloseResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/configs/config/change_requests/change_request/close",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.ChangeRequest, resp.APIResponse, err
+}
+
+// Close closes a change request without applying it.
+func (c Client) Close(ctx context.Context, opts *doppler.ChangeRequestCloseOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	return c.close(ctx, opts)
+}
+
+// Close closes a change request without applying it using the default client.
+func Close(ctx context.Context, opts *doppler.ChangeRequestCloseOptions) (*doppler.ChangeRequest, doppler.APIResponse, error) {
+	return Default().Close(ctx, opts)
+}
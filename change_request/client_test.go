@@ -0,0 +1,827 @@
+package changerequest_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/nikoksr/doppler-go"
+	changerequest "github.com/nikoksr/doppler-go/change_request"
+	"github.com/nikoksr/doppler-go/pointer"
+)
+
+func TestDefault(t *testing.T) {
+	t.Parallel()
+
+	client := changerequest.Default()
+	if client == nil {
+		t.Fatal("Expected client to be set")
+	}
+	if client.Backend == nil {
+		t.Fatal("Expected client backend to be set")
+	}
+	if client.Key != doppler.Key {
+		t.Fatalf("Expected client key to be %q, got %q", doppler.Key, client.Key)
+	}
+}
+
+func TestChangeRequest_List(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		options            *doppler.ChangeRequestListOptions
+		wantChangeRequests []*doppler.ChangeRequest
+		wantResponse       doppler.APIResponse
+		wantErr            bool
+	}{
+		{
+			name:    "List change requests",
+			options: &doppler.ChangeRequestListOptions{Project: "backend", Config: "prd", Status: pointer.To(doppler.ChangeRequestStatusOpen)},
+			wantChangeRequests: []*doppler.ChangeRequest{
+				{
+					Slug:    pointer.To("cr-1"),
+					Title:   pointer.To("Rotate database password"),
+					Project: pointer.To("backend"),
+					Config:  pointer.To("prd"),
+					Status:  pointer.To(doppler.ChangeRequestStatusOpen),
+					Secrets: map[string]string{"DB_PASSWORD": "s3cr3t"},
+					Author:  &doppler.User{Email: pointer.To("bot@example.com")},
+					Reviewers: []*doppler.ChangeRequestReviewer{
+						{User: &doppler.User{Email: pointer.To("alice@example.com")}, Status: pointer.To(doppler.ReviewStatusPending)},
+					},
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:               "List change requests with error",
+			options:            &doppler.ChangeRequestListOptions{Project: "backend", Config: "prd"},
+			wantChangeRequests: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "403 Forbidden",
+				StatusCode: http.StatusForbidden,
+				Messages:   []string{"Missing permission"},
+			},
+			wantErr: true,
+		},
+		{
+			name:               "List change requests with invalid status",
+			options:            &doppler.ChangeRequestListOptions{Project: "backend", Config: "prd", Status: pointer.To(doppler.ChangeRequestStatus("merged"))},
+			wantChangeRequests: nil,
+			wantResponse:       doppler.APIResponse{},
+			wantErr:            true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/configs/config/change_requests" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if q := r.URL.Query(); tt.options != nil && tt.options.Status != nil && q.Get("status") != string(*tt.options.Status) {
+					t.Errorf("Unexpected status query parameter: %q", q.Get("status"))
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ChangeRequestListResponse{
+					ChangeRequests: tt.wantChangeRequests,
+					APIResponse:    tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &changerequest.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotChangeRequests, gotResponse, err := client.List(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantChangeRequests, gotChangeRequests); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChangeRequest_Get(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		options           *doppler.ChangeRequestGetOptions
+		wantChangeRequest *doppler.ChangeRequest
+		wantResponse      doppler.APIResponse
+		wantErr           bool
+	}{
+		{
+			name:    "Get change request",
+			options: &doppler.ChangeRequestGetOptions{Project: "backend", Config: "prd", Slug: "cr-1"},
+			wantChangeRequest: &doppler.ChangeRequest{
+				Slug:    pointer.To("cr-1"),
+				Title:   pointer.To("Rotate database password"),
+				Project: pointer.To("backend"),
+				Config:  pointer.To("prd"),
+				Status:  pointer.To(doppler.ChangeRequestStatusOpen),
+				Secrets: map[string]string{"DB_PASSWORD": "s3cr3t"},
+				Author:  &doppler.User{Email: pointer.To("bot@example.com")},
+				Reviewers: []*doppler.ChangeRequestReviewer{
+					{User: &doppler.User{Email: pointer.To("alice@example.com")}, Status: pointer.To(doppler.ReviewStatusPending)},
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:              "Get change request with error",
+			options:           &doppler.ChangeRequestGetOptions{Project: "backend", Config: "prd", Slug: "cr-1"},
+			wantChangeRequest: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Change request not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:              "Get change request without slug",
+			options:           &doppler.ChangeRequestGetOptions{Project: "backend", Config: "prd"},
+			wantChangeRequest: nil,
+			wantResponse:      doppler.APIResponse{},
+			wantErr:           true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/configs/config/change_requests/change_request" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if q := r.URL.Query(); tt.options != nil && (q.Get("project") != tt.options.Project || q.Get("config") != tt.options.Config || q.Get("change_request") != tt.options.Slug) {
+					t.Errorf("Unexpected query parameters: %s", r.URL.RawQuery)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ChangeRequestGetResponse{
+					ChangeRequest: tt.wantChangeRequest,
+					APIResponse:   tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &changerequest.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotChangeRequest, gotResponse, err := client.Get(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantChangeRequest, gotChangeRequest); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChangeRequest_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		options           *doppler.ChangeRequestCreateOptions
+		wantBody          map[string]any
+		wantChangeRequest *doppler.ChangeRequest
+		wantResponse      doppler.APIResponse
+		wantErr           bool
+	}{
+		{
+			name: "Create change request",
+			options: &doppler.ChangeRequestCreateOptions{
+				Project:    "backend",
+				Config:     "prd",
+				NewSecrets: map[string]string{"DB_PASSWORD": "s3cr3t"},
+				Title:      "Rotate database password",
+				Reviewers:  []string{"alice@example.com"},
+			},
+			wantBody: map[string]any{
+				"project":   "backend",
+				"config":    "prd",
+				"secrets":   map[string]any{"DB_PASSWORD": "s3cr3t"},
+				"title":     "Rotate database password",
+				"reviewers": []any{"alice@example.com"},
+			},
+			wantChangeRequest: &doppler.ChangeRequest{
+				Slug:    pointer.To("cr-1"),
+				Title:   pointer.To("Rotate database password"),
+				Project: pointer.To("backend"),
+				Config:  pointer.To("prd"),
+				Status:  pointer.To(doppler.ChangeRequestStatusOpen),
+				Secrets: map[string]string{"DB_PASSWORD": "s3cr3t"},
+				Author:  &doppler.User{Email: pointer.To("bot@example.com")},
+				Reviewers: []*doppler.ChangeRequestReviewer{
+					{User: &doppler.User{Email: pointer.To("alice@example.com")}, Status: pointer.To(doppler.ReviewStatusPending)},
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:              "Create change request with error",
+			options:           &doppler.ChangeRequestCreateOptions{Project: "backend", Config: "prd", NewSecrets: map[string]string{"A": "b"}, Title: "Update A"},
+			wantBody:          map[string]any{"project": "backend", "config": "prd", "secrets": map[string]any{"A": "b"}, "title": "Update A"},
+			wantChangeRequest: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"Config does not require change requests"},
+			},
+			wantErr: true,
+		},
+		{
+			name:              "Create change request without secrets",
+			options:           &doppler.ChangeRequestCreateOptions{Project: "backend", Config: "prd", Title: "Empty"},
+			wantChangeRequest: nil,
+			wantResponse:      doppler.APIResponse{},
+			wantErr:           true,
+		},
+		{
+			name:              "Create change request with invalid reviewer",
+			options:           &doppler.ChangeRequestCreateOptions{Project: "backend", Config: "prd", NewSecrets: map[string]string{"A": "b"}, Title: "Update A", Reviewers: []string{"alice"}},
+			wantChangeRequest: nil,
+			wantResponse:      doppler.APIResponse{},
+			wantErr:           true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/configs/config/change_requests" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ChangeRequestCreateResponse{
+					ChangeRequest: tt.wantChangeRequest,
+					APIResponse:   tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &changerequest.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotChangeRequest, gotResponse, err := client.Create(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantChangeRequest, gotChangeRequest); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChangeRequest_Approve(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		options           *doppler.ChangeRequestApproveOptions
+		wantBody          map[string]any
+		wantChangeRequest *doppler.ChangeRequest
+		wantResponse      doppler.APIResponse
+		wantErr           bool
+	}{
+		{
+			name:     "Approve change request",
+			options:  &doppler.ChangeRequestApproveOptions{Project: "backend", Config: "prd", Slug: "cr-1", Comment: pointer.To("LGTM")},
+			wantBody: map[string]any{"comment": "LGTM"},
+			wantChangeRequest: &doppler.ChangeRequest{
+				Slug:    pointer.To("cr-1"),
+				Title:   pointer.To("Rotate database password"),
+				Project: pointer.To("backend"),
+				Config:  pointer.To("prd"),
+				Status:  pointer.To(doppler.ChangeRequestStatusApproved),
+				Secrets: map[string]string{"DB_PASSWORD": "s3cr3t"},
+				Author:  &doppler.User{Email: pointer.To("bot@example.com")},
+				Reviewers: []*doppler.ChangeRequestReviewer{
+					{User: &doppler.User{Email: pointer.To("alice@example.com")}, Status: pointer.To(doppler.ReviewStatusApproved)},
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:              "Approve change request with error",
+			options:           &doppler.ChangeRequestApproveOptions{Project: "backend", Config: "prd", Slug: "cr-1"},
+			wantBody:          map[string]any{},
+			wantChangeRequest: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "403 Forbidden",
+				StatusCode: http.StatusForbidden,
+				Messages:   []string{"Authors cannot approve their own change requests"},
+			},
+			wantErr: true,
+		},
+		{
+			name:              "Approve change request without slug",
+			options:           &doppler.ChangeRequestApproveOptions{Project: "backend", Config: "prd"},
+			wantChangeRequest: nil,
+			wantResponse:      doppler.APIResponse{},
+			wantErr:           true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/configs/config/change_requests/change_request/approve" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if q := r.URL.Query(); tt.options != nil && (q.Get("project") != tt.options.Project || q.Get("config") != tt.options.Config || q.Get("change_request") != tt.options.Slug) {
+					t.Errorf("Unexpected query parameters: %s", r.URL.RawQuery)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ChangeRequestApproveResponse{
+					ChangeRequest: tt.wantChangeRequest,
+					APIResponse:   tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &changerequest.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotChangeRequest, gotResponse, err := client.Approve(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantChangeRequest, gotChangeRequest); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChangeRequest_Reject(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		options           *doppler.ChangeRequestRejectOptions
+		wantBody          map[string]any
+		wantChangeRequest *doppler.ChangeRequest
+		wantResponse      doppler.APIResponse
+		wantErr           bool
+	}{
+		{
+			name:     "Reject change request",
+			options:  &doppler.ChangeRequestRejectOptions{Project: "backend", Config: "prd", Slug: "cr-1", Comment: pointer.To("Wrong value")},
+			wantBody: map[string]any{"comment": "Wrong value"},
+			wantChangeRequest: &doppler.ChangeRequest{
+				Slug:    pointer.To("cr-1"),
+				Title:   pointer.To("Rotate database password"),
+				Project: pointer.To("backend"),
+				Config:  pointer.To("prd"),
+				Status:  pointer.To(doppler.ChangeRequestStatusRejected),
+				Secrets: map[string]string{"DB_PASSWORD": "s3cr3t"},
+				Author:  &doppler.User{Email: pointer.To("bot@example.com")},
+				Reviewers: []*doppler.ChangeRequestReviewer{
+					{User: &doppler.User{Email: pointer.To("alice@example.com")}, Status: pointer.To(doppler.ReviewStatusRejected)},
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:              "Reject change request with error",
+			options:           &doppler.ChangeRequestRejectOptions{Project: "backend", Config: "prd", Slug: "cr-1"},
+			wantBody:          map[string]any{},
+			wantChangeRequest: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "409 Conflict",
+				StatusCode: http.StatusConflict,
+				Messages:   []string{"Change request is already applied"},
+			},
+			wantErr: true,
+		},
+		{
+			name:              "Reject change request without slug",
+			options:           &doppler.ChangeRequestRejectOptions{Project: "backend", Config: "prd"},
+			wantChangeRequest: nil,
+			wantResponse:      doppler.APIResponse{},
+			wantErr:           true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/configs/config/change_requests/change_request/reject" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if q := r.URL.Query(); tt.options != nil && (q.Get("project") != tt.options.Project || q.Get("config") != tt.options.Config || q.Get("change_request") != tt.options.Slug) {
+					t.Errorf("Unexpected query parameters: %s", r.URL.RawQuery)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ChangeRequestRejectResponse{
+					ChangeRequest: tt.wantChangeRequest,
+					APIResponse:   tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &changerequest.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotChangeRequest, gotResponse, err := client.Reject(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantChangeRequest, gotChangeRequest); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChangeRequest_Apply(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		options           *doppler.ChangeRequestApplyOptions
+		wantChangeRequest *doppler.ChangeRequest
+		wantResponse      doppler.APIResponse
+		wantErr           bool
+	}{
+		{
+			name:    "Apply change request",
+			options: &doppler.ChangeRequestApplyOptions{Project: "backend", Config: "prd", Slug: "cr-1"},
+			wantChangeRequest: &doppler.ChangeRequest{
+				Slug:    pointer.To("cr-1"),
+				Title:   pointer.To("Rotate database password"),
+				Project: pointer.To("backend"),
+				Config:  pointer.To("prd"),
+				Status:  pointer.To(doppler.ChangeRequestStatusApplied),
+				Secrets: map[string]string{"DB_PASSWORD": "s3cr3t"},
+				Author:  &doppler.User{Email: pointer.To("bot@example.com")},
+				Reviewers: []*doppler.ChangeRequestReviewer{
+					{User: &doppler.User{Email: pointer.To("alice@example.com")}, Status: pointer.To(doppler.ReviewStatusApproved)},
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:              "Apply change request with error",
+			options:           &doppler.ChangeRequestApplyOptions{Project: "backend", Config: "prd", Slug: "cr-1"},
+			wantChangeRequest: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "409 Conflict",
+				StatusCode: http.StatusConflict,
+				Messages:   []string{"Change request is not approved"},
+			},
+			wantErr: true,
+		},
+		{
+			name:              "Apply change request without slug",
+			options:           &doppler.ChangeRequestApplyOptions{Project: "backend", Config: "prd"},
+			wantChangeRequest: nil,
+			wantResponse:      doppler.APIResponse{},
+			wantErr:           true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/configs/config/change_requests/change_request/apply" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if q := r.URL.Query(); tt.options != nil && (q.Get("project") != tt.options.Project || q.Get("config") != tt.options.Config || q.Get("change_request") != tt.options.Slug) {
+					t.Errorf("Unexpected query parameters: %s", r.URL.RawQuery)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ChangeRequestApplyResponse{
+					ChangeRequest: tt.wantChangeRequest,
+					APIResponse:   tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &changerequest.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotChangeRequest, gotResponse, err := client.Apply(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantChangeRequest, gotChangeRequest); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChangeRequest_Close(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		options           *doppler.ChangeRequestCloseOptions
+		wantChangeRequest *doppler.ChangeRequest
+		wantResponse      doppler.APIResponse
+		wantErr           bool
+	}{
+		{
+			name:    "Close change request",
+			options: &doppler.ChangeRequestCloseOptions{Project: "backend", Config: "prd", Slug: "cr-1"},
+			wantChangeRequest: &doppler.ChangeRequest{
+				Slug:    pointer.To("cr-1"),
+				Title:   pointer.To("Rotate database password"),
+				Project: pointer.To("backend"),
+				Config:  pointer.To("prd"),
+				Status:  pointer.To(doppler.ChangeRequestStatusClosed),
+				Secrets: map[string]string{"DB_PASSWORD": "s3cr3t"},
+				Author:  &doppler.User{Email: pointer.To("bot@example.com")},
+				Reviewers: []*doppler.ChangeRequestReviewer{
+					{User: &doppler.User{Email: pointer.To("alice@example.com")}, Status: pointer.To(doppler.ReviewStatusPending)},
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:              "Close change request with error",
+			options:           &doppler.ChangeRequestCloseOptions{Project: "backend", Config: "prd", Slug: "cr-1"},
+			wantChangeRequest: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Change request not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:              "Close change request without slug",
+			options:           &doppler.ChangeRequestCloseOptions{Project: "backend", Config: "prd"},
+			wantChangeRequest: nil,
+			wantResponse:      doppler.APIResponse{},
+			wantErr:           true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/configs/config/change_requests/change_request/close" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if q := r.URL.Query(); tt.options != nil && (q.Get("project") != tt.options.Project || q.Get("config") != tt.options.Config || q.Get("change_request") != tt.options.Slug) {
+					t.Errorf("Unexpected query parameters: %s", r.URL.RawQuery)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ChangeRequestCloseResponse{
+					ChangeRequest: tt.wantChangeRequest,
+					APIResponse:   tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &changerequest.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotChangeRequest, gotResponse, err := client.Close(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantChangeRequest, gotChangeRequest); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
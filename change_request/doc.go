@@ -0,0 +1,30 @@
+/*
+Package changerequest provides a client for the Doppler API's change request endpoints.
+
+Change requests route secret edits through a review before they're written to a config. Automated rotations can
+propose their edits the same way humans do, instead of updating the secrets directly.
+
+Example:
+
+	// Propose a rotated database password for the production config
+	cr, _, err := changerequest.Create(context.Background(), &doppler.ChangeRequestCreateOptions{
+		Project:    "backend",
+		Config:     "prd",
+		Title:      "Rotate database password",
+		NewSecrets: map[string]string{"DB_PASSWORD": newPassword},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Once a reviewer approved it, apply the edits
+	_, _, err = changerequest.Apply(context.Background(), &doppler.ChangeRequestApplyOptions{
+		Project: "backend",
+		Config:  "prd",
+		Slug:    *cr.Slug,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+*/
+package changerequest
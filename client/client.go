@@ -0,0 +1,161 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/nikoksr/doppler-go"
+	activitylog "github.com/nikoksr/doppler-go/activity_log"
+	"github.com/nikoksr/doppler-go/audit"
+	"github.com/nikoksr/doppler-go/auth"
+	changerequest "github.com/nikoksr/doppler-go/change_request"
+	"github.com/nikoksr/doppler-go/config"
+	configlog "github.com/nikoksr/doppler-go/config_log"
+	dynamicsecret "github.com/nikoksr/doppler-go/dynamic_secret"
+	"github.com/nikoksr/doppler-go/environment"
+	"github.com/nikoksr/doppler-go/group"
+	"github.com/nikoksr/doppler-go/integration"
+	"github.com/nikoksr/doppler-go/logging"
+	"github.com/nikoksr/doppler-go/project"
+	projectmember "github.com/nikoksr/doppler-go/project_member"
+	"github.com/nikoksr/doppler-go/role"
+	"github.com/nikoksr/doppler-go/secret"
+	serviceaccount "github.com/nikoksr/doppler-go/service_account"
+	servicetoken "github.com/nikoksr/doppler-go/service_token"
+	"github.com/nikoksr/doppler-go/share"
+	"github.com/nikoksr/doppler-go/sync"
+	"github.com/nikoksr/doppler-go/webhook"
+	"github.com/nikoksr/doppler-go/workplace"
+	workplaceuser "github.com/nikoksr/doppler-go/workplace_user"
+)
+
+// API is the root client holding all service clients of the SDK. All service clients share the same backend and API
+// key.
+type API struct {
+	ActivityLogs    *activitylog.Client
+	Audit           *audit.Client
+	Auth            *auth.Client
+	ChangeRequests  *changerequest.Client
+	ConfigLogs      *configlog.Client
+	Configs         *config.Client
+	DynamicSecrets  *dynamicsecret.Client
+	Environments    *environment.Client
+	Groups          *group.Client
+	Integrations    *integration.Client
+	ProjectMembers  *projectmember.Client
+	Projects        *project.Client
+	Roles           *role.Client
+	Secrets         *secret.Client
+	ServiceAccounts *serviceaccount.Client
+	ServiceTokens   *servicetoken.Client
+	Share           *share.Client
+	Syncs           *sync.Client
+	Webhooks        *webhook.Client
+	Workplace       *workplace.Client
+	WorkplaceUsers  *workplaceuser.Client
+}
+
+// Option is a function that configures the API client.
+type Option func(*options)
+
+// options holds the configuration used to build the API client.
+type options struct {
+	backend       doppler.Backend
+	backendConfig doppler.BackendConfig
+}
+
+// WithBackend sets the backend used by all service clients. If set, all other backend related options are ignored.
+func WithBackend(backend doppler.Backend) Option {
+	return func(o *options) {
+		o.backend = backend
+	}
+}
+
+// WithHTTPClient sets the HTTP client used by the backend.
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options) {
+		o.backendConfig.Client = client
+	}
+}
+
+// WithURL sets the base URL of the API used by the backend.
+func WithURL(url string) Option {
+	return func(o *options) {
+		o.backendConfig.URL = &url
+	}
+}
+
+// WithLogger sets the logger used by the backend.
+func WithLogger(logger logging.Logger) Option {
+	return func(o *options) {
+		o.backendConfig.Logger = logger
+	}
+}
+
+// WithAppInfo sets the information about the "app" which this integration belongs to. Other than doppler.SetAppInfo,
+// this only affects the returned API client.
+func WithAppInfo(info *doppler.AppInfo) Option {
+	return func(o *options) {
+		o.backendConfig.AppInfo = info
+	}
+}
+
+// WithCredentials sets the provider of the API key used by the backend. It's consulted for every request, which
+// allows rotating the key at runtime. The provider takes precedence over the key passed to New, which may be empty.
+func WithCredentials(provider doppler.CredentialProvider) Option {
+	return func(o *options) {
+		o.backendConfig.Credentials = provider
+	}
+}
+
+// WithTokenScopeCheck makes the backend fail requests that are bound to fail because the type of the used token has no
+// access to the requested endpoint, without sending them. See doppler.BackendConfig.CheckTokenScope.
+func WithTokenScopeCheck() Option {
+	return func(o *options) {
+		o.backendConfig.CheckTokenScope = true
+	}
+}
+
+// New returns a new API client using the given API key. All service clients share a single backend which is built
+// from the given options.
+func New(key string, opts ...Option) *API {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	backend := o.backend
+	if backend == nil {
+		o.backendConfig.Key = key
+		backend = doppler.GetBackendWithConfig(&o.backendConfig)
+	}
+
+	api := &API{}
+	api.Init(key, backend)
+
+	return api
+}
+
+// Init initializes all service clients of the API client with the given API key and backend.
+func (a *API) Init(key string, backend doppler.Backend) {
+	a.ActivityLogs = &activitylog.Client{Backend: backend, Key: key}
+	a.Audit = &audit.Client{Backend: backend, Key: key}
+	a.Auth = &auth.Client{Backend: backend, Key: key}
+	a.ChangeRequests = &changerequest.Client{Backend: backend, Key: key}
+	a.ConfigLogs = &configlog.Client{Backend: backend, Key: key}
+	a.Configs = &config.Client{Backend: backend, Key: key}
+	a.DynamicSecrets = &dynamicsecret.Client{Backend: backend, Key: key}
+	a.Environments = &environment.Client{Backend: backend, Key: key}
+	a.Groups = &group.Client{Backend: backend, Key: key}
+	a.Integrations = &integration.Client{Backend: backend, Key: key}
+	a.ProjectMembers = &projectmember.Client{Backend: backend, Key: key}
+	a.Projects = &project.Client{Backend: backend, Key: key}
+	a.Roles = &role.Client{Backend: backend, Key: key}
+	a.Secrets = &secret.Client{Backend: backend, Key: key}
+	a.ServiceAccounts = &serviceaccount.Client{Backend: backend, Key: key}
+	a.ServiceTokens = &servicetoken.Client{Backend: backend, Key: key}
+	a.Share = &share.Client{Backend: backend, Key: key}
+	a.Syncs = &sync.Client{Backend: backend, Key: key}
+	a.Webhooks = &webhook.Client{Backend: backend, Key: key}
+	a.Workplace = &workplace.Client{Backend: backend, Key: key}
+	a.WorkplaceUsers = &workplaceuser.Client{Backend: backend, Key: key}
+}
@@ -0,0 +1,163 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/client"
+	"github.com/nikoksr/doppler-go/pointer"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	api := client.New("test-key")
+	if api == nil {
+		t.Fatal("Expected API client to be set")
+	}
+
+	// All service clients must be set and share the same backend and key.
+	backend := api.Projects.Backend
+	if backend == nil {
+		t.Fatal("Expected backend to be set")
+	}
+
+	clients := []struct {
+		name    string
+		backend doppler.Backend
+		key     string
+	}{
+		{"ActivityLogs", api.ActivityLogs.Backend, api.ActivityLogs.Key},
+		{"Audit", api.Audit.Backend, api.Audit.Key},
+		{"Auth", api.Auth.Backend, api.Auth.Key},
+		{"ChangeRequests", api.ChangeRequests.Backend, api.ChangeRequests.Key},
+		{"ConfigLogs", api.ConfigLogs.Backend, api.ConfigLogs.Key},
+		{"Configs", api.Configs.Backend, api.Configs.Key},
+		{"DynamicSecrets", api.DynamicSecrets.Backend, api.DynamicSecrets.Key},
+		{"Environments", api.Environments.Backend, api.Environments.Key},
+		{"Groups", api.Groups.Backend, api.Groups.Key},
+		{"Integrations", api.Integrations.Backend, api.Integrations.Key},
+		{"ProjectMembers", api.ProjectMembers.Backend, api.ProjectMembers.Key},
+		{"Projects", api.Projects.Backend, api.Projects.Key},
+		{"Roles", api.Roles.Backend, api.Roles.Key},
+		{"Secrets", api.Secrets.Backend, api.Secrets.Key},
+		{"ServiceAccounts", api.ServiceAccounts.Backend, api.ServiceAccounts.Key},
+		{"ServiceTokens", api.ServiceTokens.Backend, api.ServiceTokens.Key},
+		{"Share", api.Share.Backend, api.Share.Key},
+		{"Syncs", api.Syncs.Backend, api.Syncs.Key},
+		{"Webhooks", api.Webhooks.Backend, api.Webhooks.Key},
+		{"Workplace", api.Workplace.Backend, api.Workplace.Key},
+		{"WorkplaceUsers", api.WorkplaceUsers.Backend, api.WorkplaceUsers.Key},
+	}
+	for _, c := range clients {
+		if c.backend != backend {
+			t.Errorf("Expected %s client to share the backend", c.name)
+		}
+		if c.key != "test-key" {
+			t.Errorf("Expected %s client key to be %q, got %q", c.name, "test-key", c.key)
+		}
+	}
+}
+
+func TestNew_WithBackend(t *testing.T) {
+	t.Parallel()
+
+	backend := doppler.GetBackend()
+	api := client.New("test-key", client.WithBackend(backend))
+
+	if api.Secrets.Backend != backend {
+		t.Fatal("Expected the given backend to be used")
+	}
+}
+
+func TestNew_Options(t *testing.T) {
+	t.Parallel()
+
+	// Create a new httptest.Server that records the request's key and user agent.
+	var gotKey, gotUserAgent string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotKey, _, _ = r.BasicAuth()
+		gotUserAgent = r.UserAgent()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(&doppler.ProjectListResponse{
+			APIResponse: doppler.APIResponse{Success: pointer.To(true)},
+		})
+		if err != nil {
+			t.Fatalf("Failed to write response: %v", err)
+		}
+	}))
+	defer ts.Close()
+
+	api := client.New("test-key",
+		client.WithURL(ts.URL),
+		client.WithHTTPClient(ts.Client()),
+		client.WithAppInfo(&doppler.AppInfo{Name: "test-app", Version: "1.0.0"}),
+	)
+
+	if _, _, err := api.Projects.List(context.Background(), nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if gotKey != "test-key" {
+		t.Errorf("Expected key to be %q, got %q", "test-key", gotKey)
+	}
+	if !strings.HasSuffix(gotUserAgent, "test-app/1.0.0") {
+		t.Errorf("Expected user agent to contain app info, got %q", gotUserAgent)
+	}
+}
+
+func TestNew_WithCredentials(t *testing.T) {
+	t.Parallel()
+
+	// Create a new httptest.Server that records the request's key.
+	var gotKey string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotKey, _, _ = r.BasicAuth()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(&doppler.ProjectListResponse{
+			APIResponse: doppler.APIResponse{Success: pointer.To(true)},
+		})
+		if err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer ts.Close()
+
+	// The provider is used with and without a key passed to New.
+	for _, key := range []string{"", "test-key"} {
+		api := client.New(key,
+			client.WithURL(ts.URL),
+			client.WithCredentials(doppler.StaticCredentials("provided-key")),
+		)
+
+		if _, _, err := api.Projects.List(context.Background(), nil); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if gotKey != "provided-key" {
+			t.Errorf("New(%q): expected key to be %q, got %q", key, "provided-key", gotKey)
+		}
+	}
+}
+
+func TestNew_WithTokenScopeCheck(t *testing.T) {
+	t.Parallel()
+
+	// A service token can't create projects; the request must never reach the API.
+	api := client.New("dp.st.dev.abc", client.WithURL("http://localhost:0"), client.WithTokenScopeCheck())
+
+	_, _, err := api.Projects.Create(context.Background(), &doppler.ProjectCreateOptions{Name: "test"})
+	var scopeErr *doppler.TokenScopeError
+	if !errors.As(err, &scopeErr) {
+		t.Fatalf("Expected a TokenScopeError, got %v", err)
+	}
+}
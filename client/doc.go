@@ -0,0 +1,21 @@
+/*
+Package client provides a single entry point to all of the Doppler API's service clients.
+
+Other than the package level functions of the service packages, the API client does not depend on any package level
+state like doppler.Key. This makes it possible to use multiple API keys in the same program.
+
+Example:
+
+	// Create a new API client.
+	api := client.New("YOUR_API_KEY", client.WithAppInfo(&doppler.AppInfo{Name: "my-app"}))
+
+	// Fetch a list of projects.
+	projects, _, err := api.Projects.List(context.Background(), nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Print the list of projects.
+	fmt.Printf("%+v", projects)
+*/
+package client
@@ -3,14 +3,33 @@ package doppler
 type (
 	// Config represents a Doppler configuration.
 	Config struct {
-		Name           *string `json:"name,omitempty"`             // Name of the configuration.
-		Project        *string `json:"project,omitempty"`          // Identifier of the project that the config belongs to.
-		Environment    *string `json:"environment,omitempty"`      // Identifier of the environment that the config belongs to.
-		Root           *bool   `json:"root,omitempty"`             // Whether the config is the root of the environment.
-		Locked         *bool   `json:"locked,omitempty"`           // Whether the config can be renamed and/or deleted.
-		InitialFetchAt *string `json:"initial_fetch_at,omitempty"` // Date and time of the first secrets fetch.
-		LastFetchAt    *string `json:"last_fetch_at,omitempty"`    // Date and time of the last secrets fetch.
-		CreatedAt      *string `json:"created_at,omitempty"`       // Date and time of the object's creation.
+		Name           *string           `json:"name,omitempty"`             // Name of the configuration.
+		Project        *string           `json:"project,omitempty"`          // Identifier of the project that the config belongs to.
+		Environment    *string           `json:"environment,omitempty"`      // Identifier of the environment that the config belongs to.
+		Root           *bool             `json:"root,omitempty"`             // Whether the config is the root of the environment.
+		Locked         *bool             `json:"locked,omitempty"`           // Whether the config can be renamed and/or deleted.
+		Inheritable    *bool             `json:"inheritable,omitempty"`      // Whether other configs can inherit secrets from the config.
+		Inherits       []ConfigReference `json:"inherits,omitempty"`         // Configs the config inherits secrets from.
+		InheritedBy    []ConfigReference `json:"inherited_by,omitempty"`     // Configs that inherit secrets from the config.
+		InitialFetchAt *string           `json:"initial_fetch_at,omitempty"` // Date and time of the first secrets fetch.
+		LastFetchAt    *string           `json:"last_fetch_at,omitempty"`    // Date and time of the last secrets fetch.
+		CreatedAt      *string           `json:"created_at,omitempty"`       // Date and time of the object's creation.
+	}
+
+	// ConfigReference identifies a config by its project and name.
+	ConfigReference struct {
+		Project string `json:"project" validate:"required"` // Identifier of the project that the config belongs to.
+		Config  string `json:"config" validate:"required"`  // Name of the config.
+	}
+
+	// SecretSource describes where the value of a config's secret likely comes from. The API doesn't report which
+	// secrets are inherited, so the source is inferred by comparing raw values: a secret counts as inherited if an
+	// inherited config defines it with the same raw value. A local secret that happens to equal an inherited value is
+	// hence reported as inherited. If several inherited configs match, the source is ambiguous and From is unset.
+	SecretSource struct {
+		Inherited  bool              `json:"inherited"`            // Whether an inherited config defines the secret with the same value.
+		From       ConfigReference   `json:"from"`                 // The config defining the secret; the config itself for local secrets.
+		Candidates []ConfigReference `json:"candidates,omitempty"` // The inherited configs defining the secret with the same value.
 	}
 
 	// ConfigGetResponse represents a response from the config get endpoint.
@@ -25,8 +44,8 @@ type (
 
 	// ConfigGetOptions represents the options for the config get endpoint.
 	ConfigGetOptions struct {
-		Project string `url:"project" json:"-"` // Identifier of the project that the config belongs to.
-		Config  string `url:"config" json:"-"`  // Name of the config.
+		Project string `url:"project" json:"-" validate:"required"` // Identifier of the project that the config belongs to.
+		Config  string `url:"config" json:"-" validate:"required"`  // Name of the config.
 	}
 
 	// ConfigListResponse represents a response from the config list endpoint.
@@ -42,7 +61,8 @@ type (
 	// ConfigListOptions represents the query parameters for a config list request.
 	ConfigListOptions struct {
 		ListOptions `url:",inline" json:"-"`
-		Project     string `url:"project" json:"-"` // Identifier of the project that the config belongs to.
+		Project     string  `url:"project" json:"-" validate:"required"` // Identifier of the project that the config belongs to.
+		Environment *string `url:"environment,omitempty" json:"-"`       // Only list the configs of the environment with the given slug.
 	}
 
 	// ConfigCreateResponse represents a response from the config create endpoint.
@@ -57,9 +77,9 @@ type (
 
 	// ConfigCreateOptions represents the body parameters for a config create request.
 	ConfigCreateOptions struct {
-		Project     string `url:"-" json:"project"`     // Identifier of the project that the config belongs to.
-		Environment string `url:"-" json:"environment"` // Identifier of the environment that the config belongs to.
-		Name        string `url:"-" json:"name"`        // Name of the new branch configuration.
+		Project     string `url:"-" json:"project" validate:"required"`     // Identifier of the project that the config belongs to.
+		Environment string `url:"-" json:"environment" validate:"required"` // Identifier of the environment that the config belongs to.
+		Name        string `url:"-" json:"name" validate:"required"`        // Name of the new branch configuration.
 	}
 
 	// ConfigUpdateResponse represents a doppler config update request.
@@ -74,9 +94,9 @@ type (
 
 	// ConfigUpdateOptions represents the body parameters for a config update request.
 	ConfigUpdateOptions struct {
-		Project string `url:"-" json:"project"` // Identifier of the project that the config belongs to.
-		Config  string `url:"-" json:"config"`  // Name of the config.
-		NewName string `url:"-" json:"name"`    // New name of the config.
+		Project string `url:"-" json:"project" validate:"required"` // Identifier of the project that the config belongs to.
+		Config  string `url:"-" json:"config" validate:"required"`  // Name of the config.
+		NewName string `url:"-" json:"name" validate:"required"`    // New name of the config.
 	}
 
 	// ConfigDeleteResponse represents a response from the config delete endpoint.
@@ -90,8 +110,8 @@ type (
 
 	// ConfigDeleteOptions represents the body parameters for a config delete request.
 	ConfigDeleteOptions struct {
-		Project string `url:"-" json:"project"` // Identifier of the project that the config belongs to.
-		Config  string `url:"-" json:"config"`  // Name of the config.
+		Project string `url:"-" json:"project" validate:"required"` // Identifier of the project that the config belongs to.
+		Config  string `url:"-" json:"config" validate:"required"`  // Name of the config.
 	}
 
 	// ConfigLockResponse represents a response from the config lock endpoint.
@@ -106,8 +126,8 @@ type (
 
 	// ConfigLockOptions represents the body parameters for a config lock request.
 	ConfigLockOptions struct {
-		Project string `url:"-" json:"project"` // Identifier of the project that the config belongs to.
-		Config  string `url:"-" json:"config"`  // Name of the config.
+		Project string `url:"-" json:"project" validate:"required"` // Identifier of the project that the config belongs to.
+		Config  string `url:"-" json:"config" validate:"required"`  // Name of the config.
 	}
 
 	// ConfigUnlockResponse represents a response from the config unlock endpoint.
@@ -122,8 +142,8 @@ type (
 
 	// ConfigUnlockOptions represents the body parameters for a config unlock request.
 	ConfigUnlockOptions struct {
-		Project string `url:"-" json:"project"` // Identifier of the project that the config belongs to.
-		Config  string `url:"-" json:"config"`  // Name of the config.
+		Project string `url:"-" json:"project" validate:"required"` // Identifier of the project that the config belongs to.
+		Config  string `url:"-" json:"config" validate:"required"`  // Name of the config.
 	}
 
 	// ConfigCloneResponse represents a response from the config clone endpoint.
@@ -138,8 +158,91 @@ type (
 
 	// ConfigCloneOptions represents the body parameters for a config clone request.
 	ConfigCloneOptions struct {
-		Project   string `url:"-" json:"project"` // Identifier of the project that the config belongs to.
-		Config    string `url:"-" json:"config"`  // Name of the config.
-		NewConfig string `url:"-" json:"name"`    // Name of the new config.
+		Project   string `url:"-" json:"project" validate:"required"` // Identifier of the project that the config belongs to.
+		Config    string `url:"-" json:"config" validate:"required"`  // Name of the config.
+		NewConfig string `url:"-" json:"name" validate:"required"`    // Name of the new config.
+	}
+
+	// ConfigTrustedIPListResponse represents a response from the config trusted IPs list endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/configs/config/trusted_ips
+	// Docs:     https://docs.doppler.com/reference/config-trusted_ips-list
+	ConfigTrustedIPListResponse struct {
+		APIResponse `json:",inline"`
+		IPs         []string `json:"ips"`
+	}
+
+	// ConfigTrustedIPListOptions represents the query parameters for a config trusted IPs list request.
+	ConfigTrustedIPListOptions struct {
+		Project string `url:"project" json:"-" validate:"required"` // Identifier of the project that the config belongs to.
+		Config  string `url:"config" json:"-" validate:"required"`  // Name of the config.
+	}
+
+	// ConfigTrustedIPAddResponse represents a response from the config trusted IPs add endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/configs/config/trusted_ips
+	// Docs:     https://docs.doppler.com/reference/config-trusted_ips-add
+	ConfigTrustedIPAddResponse struct {
+		APIResponse `json:",inline"`
+		IP          *string `json:"ip,omitempty"`
+	}
+
+	// ConfigTrustedIPAddOptions represents the parameters for a config trusted IPs add request.
+	ConfigTrustedIPAddOptions struct {
+		Project string `url:"project" json:"-" validate:"required"` // Identifier of the project that the config belongs to.
+		Config  string `url:"config" json:"-" validate:"required"`  // Name of the config.
+		IP      string `url:"-" json:"ip" validate:"required"`      // IP address or CIDR range to trust, e.g. "10.0.0.0/16".
+	}
+
+	// ConfigTrustedIPRemoveResponse represents a response from the config trusted IPs remove endpoint.
+	//
+	// Method:   DELETE
+	// Endpoint: https://api.doppler.com/v3/configs/config/trusted_ips
+	// Docs:     https://docs.doppler.com/reference/config-trusted_ips-delete
+	ConfigTrustedIPRemoveResponse struct {
+		APIResponse `json:",inline"`
+	}
+
+	// ConfigTrustedIPRemoveOptions represents the parameters for a config trusted IPs remove request.
+	ConfigTrustedIPRemoveOptions struct {
+		Project string `url:"project" json:"-" validate:"required"` // Identifier of the project that the config belongs to.
+		Config  string `url:"config" json:"-" validate:"required"`  // Name of the config.
+		IP      string `url:"-" json:"ip" validate:"required"`      // IP address or CIDR range to remove from the trusted IPs.
+	}
+
+	// ConfigSetInheritableResponse represents a response from the config inheritable endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/configs/config/inheritable
+	// Docs:     https://docs.doppler.com/reference/configs-update-inheritable
+	ConfigSetInheritableResponse struct {
+		APIResponse `json:",inline"`
+		Config      *Config `json:"config"`
+	}
+
+	// ConfigSetInheritableOptions represents the body parameters for a config inheritable request.
+	ConfigSetInheritableOptions struct {
+		Project     string `url:"-" json:"project" validate:"required"` // Identifier of the project that the config belongs to.
+		Config      string `url:"-" json:"config" validate:"required"`  // Name of the config.
+		Inheritable bool   `url:"-" json:"inheritable"`                 // Whether other configs can inherit secrets from the config.
+	}
+
+	// ConfigSetInheritsResponse represents a response from the config inherits endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/configs/config/inherits
+	// Docs:     https://docs.doppler.com/reference/configs-update-inherits
+	ConfigSetInheritsResponse struct {
+		APIResponse `json:",inline"`
+		Config      *Config `json:"config"`
+	}
+
+	// ConfigSetInheritsOptions represents the body parameters for a config inherits request.
+	ConfigSetInheritsOptions struct {
+		Project  string            `url:"-" json:"project" validate:"required"`        // Identifier of the project that the config belongs to.
+		Config   string            `url:"-" json:"config" validate:"required"`         // Name of the config.
+		Inherits []ConfigReference `url:"-" json:"inherits" validate:"omitempty,dive"` // Configs to inherit secrets from, replacing the current ones. Empty to stop inheriting.
 	}
 )
@@ -2,9 +2,24 @@ package config
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/pkg/errors"
 
 	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/secret"
+)
+
+var (
+	// ErrNoTrustedIPs is returned by SyncTrustedIPs if no desired ranges are given. A config without trusted IPs
+	// can't be accessed from anywhere, so allowing all IPs has to be explicit, i.e. 0.0.0.0/0.
+	ErrNoTrustedIPs = errors.New("desired trusted IPs may not be empty; use 0.0.0.0/0 to allow all IPs")
+
+	// ErrInvalidTrustedIP is returned by SyncTrustedIPs if a desired range is invalid.
+	ErrInvalidTrustedIP = errors.New("invalid trusted IP range")
 )
 
 // Client is the client used to invoke /v3/configs APIs.
@@ -197,3 +212,298 @@ func (c Client) Clone(ctx context.Context, opts *doppler.ConfigCloneOptions) (*d
 func Clone(ctx context.Context, opts *doppler.ConfigCloneOptions) (*doppler.Config, doppler.APIResponse, error) {
 	return Default().Clone(ctx, opts)
 }
+
+func (c Client) trustedIPList(ctx context.Context, opts *doppler.ConfigTrustedIPListOptions) ([]string, doppler.APIResponse, error) {
+	var resp doppler.ConfigTrustedIPListResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    "/v3/configs/config/trusted_ips",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.IPs, resp.APIResponse, err
+}
+
+// TrustedIPList returns the IP addresses and CIDR ranges a config's secrets may be read from.
+func (c Client) TrustedIPList(ctx context.Context, opts *doppler.ConfigTrustedIPListOptions) ([]string, doppler.APIResponse, error) {
+	return c.trustedIPList(ctx, opts)
+}
+
+// TrustedIPList returns the IP addresses and CIDR ranges a config's secrets may be read from using the default client.
+func TrustedIPList(ctx context.Context, opts *doppler.ConfigTrustedIPListOptions) ([]string, doppler.APIResponse, error) {
+	return Default().TrustedIPList(ctx, opts)
+}
+
+func (c Client) trustedIPAdd(ctx context.Context, opts *doppler.ConfigTrustedIPAddOptions) (string, doppler.APIResponse, error) {
+	var resp doppler.ConfigTrustedIPAddResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/configs/config/trusted_ips",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	var ip string
+	if resp.IP != nil {
+		ip = *resp.IP
+	}
+
+	return ip, resp.APIResponse, err
+}
+
+// TrustedIPAdd adds an IP address or CIDR range to a config's trusted IPs.
+func (c Client) TrustedIPAdd(ctx context.Context, opts *doppler.ConfigTrustedIPAddOptions) (string, doppler.APIResponse, error) {
+	return c.trustedIPAdd(ctx, opts)
+}
+
+// TrustedIPAdd adds an IP address or CIDR range to a config's trusted IPs using the default client.
+func TrustedIPAdd(ctx context.Context, opts *doppler.ConfigTrustedIPAddOptions) (string, doppler.APIResponse, error) {
+	return Default().TrustedIPAdd(ctx, opts)
+}
+
+func (c Client) trustedIPRemove(ctx context.Context, opts *doppler.ConfigTrustedIPRemoveOptions) (doppler.APIResponse, error) {
+	var resp doppler.ConfigTrustedIPRemoveResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodDelete,
+		Path:    "/v3/configs/config/trusted_ips",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.APIResponse, err
+}
+
+// TrustedIPRemove removes an IP address or CIDR range from a config's trusted IPs.
+func (c Client) TrustedIPRemove(ctx context.Context, opts *doppler.ConfigTrustedIPRemoveOptions) (doppler.APIResponse, error) {
+	return c.trustedIPRemove(ctx, opts)
+}
+
+// TrustedIPRemove removes an IP address or CIDR range from a config's trusted IPs using the default client.
+func TrustedIPRemove(ctx context.Context, opts *doppler.ConfigTrustedIPRemoveOptions) (doppler.APIResponse, error) {
+	return Default().TrustedIPRemove(ctx, opts)
+}
+
+// parseTrustedIP parses a trusted IP as returned by the API. Single addresses are turned into a prefix covering only
+// that address, and all prefixes are masked, so that equal ranges compare equal.
+func parseTrustedIP(ip string) (netip.Prefix, error) {
+	if !strings.Contains(ip, "/") {
+		addr, err := netip.ParseAddr(ip)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := netip.ParsePrefix(ip)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return prefix.Masked(), nil
+}
+
+func (c Client) syncTrustedIPs(ctx context.Context, project, config string, desired []netip.Prefix) ([]netip.Prefix, []netip.Prefix, error) {
+	if len(desired) == 0 {
+		return nil, nil, ErrNoTrustedIPs
+	}
+
+	// Index the desired ranges, rejecting invalid ones.
+	want := make(map[netip.Prefix]bool, len(desired))
+	for _, prefix := range desired {
+		if !prefix.IsValid() {
+			return nil, nil, errors.Wrapf(ErrInvalidTrustedIP, "%q", prefix)
+		}
+		want[prefix.Masked()] = true
+	}
+
+	ips, _, err := c.trustedIPList(ctx, &doppler.ConfigTrustedIPListOptions{Project: project, Config: config})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "list trusted IPs")
+	}
+
+	// Keep the original notation of the current entries, since that's what the API expects on removal.
+	current := make(map[netip.Prefix]string, len(ips))
+	for _, ip := range ips {
+		prefix, err := parseTrustedIP(ip)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "parse trusted IP %q", ip)
+		}
+		current[prefix] = ip
+	}
+
+	// Add before removing, so that the config never ends up with fewer trusted IPs than either the current or the
+	// desired state. Iterate the input slice to keep the order of the requests deterministic.
+	var added, removed []netip.Prefix
+	for _, prefix := range desired {
+		prefix = prefix.Masked()
+		if _, ok := current[prefix]; ok {
+			continue
+		}
+
+		_, _, err := c.trustedIPAdd(ctx, &doppler.ConfigTrustedIPAddOptions{Project: project, Config: config, IP: prefix.String()})
+		if err != nil {
+			return added, removed, errors.Wrapf(err, "add trusted IP %s", prefix)
+		}
+		current[prefix] = prefix.String()
+		added = append(added, prefix)
+	}
+
+	for _, ip := range ips {
+		prefix, _ := parseTrustedIP(ip) // Parsed successfully above.
+		if want[prefix] {
+			continue
+		}
+
+		_, err := c.trustedIPRemove(ctx, &doppler.ConfigTrustedIPRemoveOptions{Project: project, Config: config, IP: ip})
+		if err != nil {
+			return added, removed, errors.Wrapf(err, "remove trusted IP %s", ip)
+		}
+		removed = append(removed, prefix)
+	}
+
+	return added, removed, nil
+}
+
+// SyncTrustedIPs makes the given ranges the only trusted IPs of a config. It adds the missing ranges first and then
+// removes the ones that aren't desired anymore, and returns the ranges it added and removed. On failure, the ranges
+// changed so far are returned along with the error.
+func (c Client) SyncTrustedIPs(ctx context.Context, project, config string, desired []netip.Prefix) (added, removed []netip.Prefix, err error) {
+	return c.syncTrustedIPs(ctx, project, config, desired)
+}
+
+// SyncTrustedIPs makes the given ranges the only trusted IPs of a config using the default client.
+func SyncTrustedIPs(ctx context.Context, project, config string, desired []netip.Prefix) (added, removed []netip.Prefix, err error) {
+	return Default().SyncTrustedIPs(ctx, project, config, desired)
+}
+
+func (c Client) setInheritable(ctx context.Context, opts *doppler.ConfigSetInheritableOptions) (*doppler.Config, doppler.APIResponse, error) {
+	var resp doppler.ConfigSetInheritableResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/configs/config/inheritable",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Config, resp.APIResponse, err
+}
+
+// SetInheritable sets whether other configs can inherit secrets from a config.
+func (c Client) SetInheritable(ctx context.Context, opts *doppler.ConfigSetInheritableOptions) (*doppler.Config, doppler.APIResponse, error) {
+	return c.setInheritable(ctx, opts)
+}
+
+// SetInheritable sets whether other configs can inherit secrets from a config using the default client.
+func SetInheritable(ctx context.Context, opts *doppler.ConfigSetInheritableOptions) (*doppler.Config, doppler.APIResponse, error) {
+	return Default().SetInheritable(ctx, opts)
+}
+
+func (c Client) setInherits(ctx context.Context, opts *doppler.ConfigSetInheritsOptions) (*doppler.Config, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	// The API expects an empty list rather than null to stop inheriting.
+	if opts.Inherits == nil {
+		optsCopy := *opts
+		optsCopy.Inherits = []doppler.ConfigReference{}
+		opts = &optsCopy
+	}
+
+	var resp doppler.ConfigSetInheritsResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/configs/config/inherits",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Config, resp.APIResponse, err
+}
+
+// SetInherits sets the configs a config inherits secrets from, replacing the current ones. The inherited configs have
+// to be inheritable.
+func (c Client) SetInherits(ctx context.Context, opts *doppler.ConfigSetInheritsOptions) (*doppler.Config, doppler.APIResponse, error) {
+	return c.setInherits(ctx, opts)
+}
+
+// SetInherits sets the configs a config inherits secrets from using the default client.
+func SetInherits(ctx context.Context, opts *doppler.ConfigSetInheritsOptions) (*doppler.Config, doppler.APIResponse, error) {
+	return Default().SetInherits(ctx, opts)
+}
+
+// listSecrets returns the secrets of the given config.
+func (c Client) listSecrets(ctx context.Context, ref doppler.ConfigReference) (map[string]*doppler.SecretValue, error) {
+	secrets := &secret.Client{Backend: c.Backend, Key: c.Key}
+	list, _, err := secrets.List(ctx, &doppler.SecretListOptions{Project: ref.Project, Config: ref.Config})
+	if err != nil {
+		return nil, errors.Wrapf(err, "list secrets of config %s/%s", ref.Project, ref.Config)
+	}
+
+	return list, nil
+}
+
+// rawValue returns the raw value of the given secret, or an empty string if it has none.
+func rawValue(value *doppler.SecretValue) string {
+	if value == nil || value.Raw == nil {
+		return ""
+	}
+
+	return *value.Raw
+}
+
+func (c Client) secretSources(ctx context.Context, project, config string) (map[string]*doppler.SecretSource, error) {
+	cfg, _, err := c.get(ctx, &doppler.ConfigGetOptions{Project: project, Config: config})
+	if err != nil {
+		return nil, errors.Wrap(err, "get config")
+	}
+	if cfg == nil {
+		return nil, errors.Errorf("config %s/%s not found", project, config)
+	}
+
+	self := doppler.ConfigReference{Project: project, Config: config}
+	secrets, err := c.listSecrets(ctx, self)
+	if err != nil {
+		return nil, err
+	}
+
+	inherited := make([]map[string]*doppler.SecretValue, len(cfg.Inherits))
+	for i, ref := range cfg.Inherits {
+		if inherited[i], err = c.listSecrets(ctx, ref); err != nil {
+			return nil, err
+		}
+	}
+
+	sources := make(map[string]*doppler.SecretSource, len(secrets))
+	for name, value := range secrets {
+		source := &doppler.SecretSource{From: self}
+		for i, ref := range cfg.Inherits {
+			if parent, ok := inherited[i][name]; ok && rawValue(parent) == rawValue(value) {
+				source.Candidates = append(source.Candidates, ref)
+			}
+		}
+		if len(source.Candidates) > 0 {
+			source.Inherited, source.From = true, source.Candidates[0]
+		}
+		if len(source.Candidates) > 1 {
+			// Several inherited configs define the same value; don't guess which one it comes from.
+			source.From = doppler.ConfigReference{}
+		}
+		sources[name] = source
+	}
+
+	return sources, nil
+}
+
+// SecretSources reports for each secret of a config whether it's likely defined by the config itself or inherited, and
+// from which config. The sources are inferred from the secrets' values; see doppler.SecretSource for the caveats.
+func (c Client) SecretSources(ctx context.Context, project, config string) (map[string]*doppler.SecretSource, error) {
+	return c.secretSources(ctx, project, config)
+}
+
+// SecretSources reports for each secret of a config whether it's local or inherited using the default client.
+func SecretSources(ctx context.Context, project, config string) (map[string]*doppler.SecretSource, error) {
+	return Default().SecretSources(ctx, project, config)
+}
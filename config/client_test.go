@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
 
 	"github.com/nikoksr/doppler-go"
 	"github.com/nikoksr/doppler-go/config"
@@ -212,6 +215,27 @@ func TestConfig_List(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "List configs of environment",
+			options: &doppler.ConfigListOptions{
+				Project:     "p1",
+				Environment: pointer.To("dev"),
+			},
+			wantConfigs: []*doppler.Config{
+				{
+					Name:        pointer.To("c1"),
+					Project:     pointer.To("p1"),
+					Environment: pointer.To("dev"),
+					Root:        pointer.To(true),
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
 		{
 			name: "List configs with invalid page",
 			options: &doppler.ConfigListOptions{
@@ -247,6 +271,14 @@ func TestConfig_List(t *testing.T) {
 
 			// Create a new httptest.Server that will be used to mock the Doppler API.
 			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// Check that the environment filter is only sent if set.
+				if tt.options.Environment != nil && r.URL.Query().Get("environment") != *tt.options.Environment {
+					t.Errorf("Unexpected environment query parameter: %q", r.URL.Query().Get("environment"))
+				}
+				if tt.options.Environment == nil && r.URL.Query().Has("environment") {
+					t.Errorf("Unexpected environment query parameter: %q", r.URL.Query().Get("environment"))
+				}
+
 				// Set the expected response headers.
 				w.Header().Set("Content-Type", "application/json")
 				w.WriteHeader(tt.wantResponse.StatusCode)
@@ -875,3 +907,837 @@ func TestConfig_Clone(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_TrustedIPList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ConfigTrustedIPListOptions
+		wantIPs      []string
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "List trusted IPs",
+			options: &doppler.ConfigTrustedIPListOptions{Project: "backend", Config: "prd"},
+			wantIPs: []string{"10.0.0.0/16", "203.0.113.7"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "List trusted IPs with error",
+			options: &doppler.ConfigTrustedIPListOptions{Project: "backend", Config: "prd"},
+			wantIPs: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Could not find requested config"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "List trusted IPs without config",
+			options:      &doppler.ConfigTrustedIPListOptions{Project: "backend"},
+			wantIPs:      nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/configs/config/trusted_ips" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if q := r.URL.Query(); tt.options != nil && (q.Get("project") != tt.options.Project || q.Get("config") != tt.options.Config) {
+					t.Errorf("Unexpected query parameters: %s", r.URL.RawQuery)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ConfigTrustedIPListResponse{
+					IPs:         tt.wantIPs,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &config.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotIPs, gotResponse, err := client.TrustedIPList(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantIPs, gotIPs); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfig_TrustedIPAdd(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ConfigTrustedIPAddOptions
+		wantBody     map[string]any
+		wantIP       string
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:     "Add trusted IP",
+			options:  &doppler.ConfigTrustedIPAddOptions{Project: "backend", Config: "prd", IP: "10.0.0.0/16"},
+			wantBody: map[string]any{"ip": "10.0.0.0/16"},
+			wantIP:   "10.0.0.0/16",
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Add trusted IP with error",
+			options:  &doppler.ConfigTrustedIPAddOptions{Project: "backend", Config: "prd", IP: "invalid"},
+			wantBody: map[string]any{"ip": "invalid"},
+			wantIP:   "",
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"Invalid IP address or CIDR range"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Add trusted IP without IP",
+			options:      &doppler.ConfigTrustedIPAddOptions{Project: "backend", Config: "prd"},
+			wantIP:       "",
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/configs/config/trusted_ips" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if q := r.URL.Query(); tt.options != nil && (q.Get("project") != tt.options.Project || q.Get("config") != tt.options.Config) {
+					t.Errorf("Unexpected query parameters: %s", r.URL.RawQuery)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ConfigTrustedIPAddResponse{
+					IP:          pointer.To(tt.wantIP),
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &config.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotIP, gotResponse, err := client.TrustedIPAdd(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantIP, gotIP); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfig_TrustedIPRemove(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ConfigTrustedIPRemoveOptions
+		wantBody     map[string]any
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:     "Remove trusted IP",
+			options:  &doppler.ConfigTrustedIPRemoveOptions{Project: "backend", Config: "prd", IP: "10.0.0.0/16"},
+			wantBody: map[string]any{"ip": "10.0.0.0/16"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Remove trusted IP with error",
+			options:  &doppler.ConfigTrustedIPRemoveOptions{Project: "backend", Config: "prd", IP: "10.0.0.0/16"},
+			wantBody: map[string]any{"ip": "10.0.0.0/16"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Could not find requested config"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Remove trusted IP without IP",
+			options:      &doppler.ConfigTrustedIPRemoveOptions{Project: "backend", Config: "prd"},
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/v3/configs/config/trusted_ips" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if q := r.URL.Query(); tt.options != nil && (q.Get("project") != tt.options.Project || q.Get("config") != tt.options.Config) {
+					t.Errorf("Unexpected query parameters: %s", r.URL.RawQuery)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ConfigTrustedIPRemoveResponse{
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &config.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotResponse, err := client.TrustedIPRemove(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// trustedIPServer mocks the trusted IPs endpoints of a single config and records the requests that changed them.
+type trustedIPServer struct {
+	mu      sync.Mutex
+	ips     []string
+	changes []string
+	failOn  string
+}
+
+func (s *trustedIPServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var body struct {
+		IP string `json:"ip"`
+	}
+	if r.Method != http.MethodGet {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if body.IP != "" && body.IP == s.failOn {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]any{"success": false, "messages": []string{"Invalid IP"}})
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		s.ips = append(s.ips, body.IP)
+	case http.MethodDelete:
+		for i, ip := range s.ips {
+			if ip == body.IP {
+				s.ips = append(s.ips[:i], s.ips[i+1:]...)
+				break
+			}
+		}
+	}
+	if r.Method != http.MethodGet {
+		s.changes = append(s.changes, r.Method+" "+body.IP)
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "ips": s.ips})
+}
+
+func TestConfig_SyncTrustedIPs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		current     []string
+		desired     []netip.Prefix
+		failOn      string
+		wantAdded   []netip.Prefix
+		wantRemoved []netip.Prefix
+		wantChanges []string
+		wantIPs     []string
+		wantErr     bool
+		wantErrIs   error
+	}{
+		{
+			name:    "Replace allow-all with NAT ranges",
+			current: []string{"0.0.0.0/0"},
+			desired: []netip.Prefix{
+				netip.MustParsePrefix("203.0.113.0/24"),
+				netip.MustParsePrefix("198.51.100.7/32"),
+			},
+			wantAdded: []netip.Prefix{
+				netip.MustParsePrefix("203.0.113.0/24"),
+				netip.MustParsePrefix("198.51.100.7/32"),
+			},
+			wantRemoved: []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0")},
+			wantChanges: []string{"POST 203.0.113.0/24", "POST 198.51.100.7/32", "DELETE 0.0.0.0/0"},
+			wantIPs:     []string{"203.0.113.0/24", "198.51.100.7/32"},
+		},
+		{
+			name:    "Keep equal ranges in other notations",
+			current: []string{"198.51.100.7", "10.0.0.0/8"},
+			desired: []netip.Prefix{
+				netip.MustParsePrefix("198.51.100.7/32"),
+				netip.MustParsePrefix("10.1.2.3/8"),
+			},
+			wantChanges: nil,
+			wantIPs:     []string{"198.51.100.7", "10.0.0.0/8"},
+		},
+		{
+			name:        "Ignore duplicate desired ranges",
+			current:     []string{"0.0.0.0/0"},
+			desired:     []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("10.0.0.0/8")},
+			wantAdded:   []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+			wantRemoved: []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0")},
+			wantChanges: []string{"POST 10.0.0.0/8", "DELETE 0.0.0.0/0"},
+			wantIPs:     []string{"10.0.0.0/8"},
+		},
+		{
+			name:    "Stop at failing addition without removing",
+			current: []string{"0.0.0.0/0"},
+			desired: []netip.Prefix{
+				netip.MustParsePrefix("203.0.113.0/24"),
+				netip.MustParsePrefix("198.51.100.0/24"),
+			},
+			failOn:      "198.51.100.0/24",
+			wantAdded:   []netip.Prefix{netip.MustParsePrefix("203.0.113.0/24")},
+			wantChanges: []string{"POST 203.0.113.0/24"},
+			wantIPs:     []string{"0.0.0.0/0", "203.0.113.0/24"},
+			wantErr:     true,
+		},
+		{
+			name:      "Reject empty desired ranges",
+			current:   []string{"0.0.0.0/0"},
+			desired:   nil,
+			wantIPs:   []string{"0.0.0.0/0"},
+			wantErr:   true,
+			wantErrIs: config.ErrNoTrustedIPs,
+		},
+		{
+			name:      "Reject invalid desired ranges",
+			current:   []string{"0.0.0.0/0"},
+			desired:   []netip.Prefix{{}},
+			wantIPs:   []string{"0.0.0.0/0"},
+			wantErr:   true,
+			wantErrIs: config.ErrInvalidTrustedIP,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := &trustedIPServer{ips: tt.current, failOn: tt.failOn}
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			client := &config.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotAdded, gotRemoved, err := client.SyncTrustedIPs(context.Background(), "backend", "prd", tt.desired)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unexpected error. Expected %t, got %v", tt.wantErr, err)
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("Unexpected error. Expected %v, got %v", tt.wantErrIs, err)
+			}
+
+			cmpPrefix := cmp.Comparer(func(a, b netip.Prefix) bool { return a == b })
+			if diff := cmp.Diff(tt.wantAdded, gotAdded, cmpPrefix); diff != "" {
+				t.Errorf("Unexpected added ranges (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantRemoved, gotRemoved, cmpPrefix); diff != "" {
+				t.Errorf("Unexpected removed ranges (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantChanges, srv.changes); diff != "" {
+				t.Errorf("Unexpected changes (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantIPs, srv.ips); diff != "" {
+				t.Errorf("Unexpected trusted IPs (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfig_SetInheritable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ConfigSetInheritableOptions
+		wantBody     map[string]any
+		wantConfig   *doppler.Config
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:     "Make config inheritable",
+			options:  &doppler.ConfigSetInheritableOptions{Project: "backend", Config: "prd", Inheritable: true},
+			wantBody: map[string]any{"project": "backend", "config": "prd", "inheritable": true},
+			wantConfig: &doppler.Config{
+				Name:        pointer.To("prd"),
+				Project:     pointer.To("backend"),
+				Inheritable: pointer.To(true),
+				InheritedBy: []doppler.ConfigReference{{Project: "frontend", Config: "prd"}},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:       "Make config not inheritable with error",
+			options:    &doppler.ConfigSetInheritableOptions{Project: "backend", Config: "prd", Inheritable: false},
+			wantBody:   map[string]any{"project": "backend", "config": "prd", "inheritable": false},
+			wantConfig: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"Config is inherited by other configs"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Make config inheritable without config",
+			options:      &doppler.ConfigSetInheritableOptions{Project: "backend", Inheritable: true},
+			wantConfig:   nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/configs/config/inheritable" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ConfigSetInheritableResponse{
+					Config:      tt.wantConfig,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &config.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotConfig, gotResponse, err := client.SetInheritable(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantConfig, gotConfig); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfig_SetInherits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ConfigSetInheritsOptions
+		wantBody     map[string]any
+		wantConfig   *doppler.Config
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "Inherit from config",
+			options: &doppler.ConfigSetInheritsOptions{Project: "frontend", Config: "prd", Inherits: []doppler.ConfigReference{{Project: "shared", Config: "prd"}}},
+			wantBody: map[string]any{
+				"project":  "frontend",
+				"config":   "prd",
+				"inherits": []any{map[string]any{"project": "shared", "config": "prd"}},
+			},
+			wantConfig: &doppler.Config{
+				Name:     pointer.To("prd"),
+				Project:  pointer.To("frontend"),
+				Inherits: []doppler.ConfigReference{{Project: "shared", Config: "prd"}},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:       "Stop inheriting",
+			options:    &doppler.ConfigSetInheritsOptions{Project: "frontend", Config: "prd"},
+			wantBody:   map[string]any{"project": "frontend", "config": "prd", "inherits": []any{}},
+			wantConfig: &doppler.Config{Name: pointer.To("prd"), Project: pointer.To("frontend")},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Inherit from config with error",
+			options: &doppler.ConfigSetInheritsOptions{Project: "frontend", Config: "prd", Inherits: []doppler.ConfigReference{{Project: "backend", Config: "dev"}}},
+			wantBody: map[string]any{
+				"project":  "frontend",
+				"config":   "prd",
+				"inherits": []any{map[string]any{"project": "backend", "config": "dev"}},
+			},
+			wantConfig: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"Config is not inheritable"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Inherit from incomplete reference",
+			options:      &doppler.ConfigSetInheritsOptions{Project: "frontend", Config: "prd", Inherits: []doppler.ConfigReference{{Project: "shared"}}},
+			wantConfig:   nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+		{
+			name:         "Inherit without options",
+			options:      nil,
+			wantConfig:   nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/configs/config/inherits" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ConfigSetInheritsResponse{
+					Config:      tt.wantConfig,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &config.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotConfig, gotResponse, err := client.SetInherits(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantConfig, gotConfig); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfig_SecretSources(t *testing.T) {
+	t.Parallel()
+
+	// Mocked configs, keyed by project and config name.
+	configs := map[string]*doppler.Config{
+		"frontend/prd": {
+			Name:    pointer.To("prd"),
+			Project: pointer.To("frontend"),
+			Inherits: []doppler.ConfigReference{
+				{Project: "shared", Config: "prd"},
+				{Project: "payments", Config: "prd"},
+			},
+		},
+		"standalone/prd": {Name: pointer.To("prd"), Project: pointer.To("standalone")},
+	}
+	secrets := map[string]map[string]*doppler.SecretValue{
+		"frontend/prd": {
+			"API_URL":        {Raw: pointer.To("https://api.example.com")},
+			"SENTRY_DSN":     {Raw: pointer.To("https://sentry.example.com/1")},
+			"STRIPE_KEY":     {Raw: pointer.To("sk_live_123")},
+			"FEATURE_FLAGS":  {Raw: pointer.To("checkout")},
+			"DOPPLER_CONFIG": {Raw: pointer.To("prd")},
+			"LOG_LEVEL":      {Raw: pointer.To("info")},
+		},
+		"shared/prd": {
+			"LOG_LEVEL":     {Raw: pointer.To("info")},
+			"SENTRY_DSN":    {Raw: pointer.To("https://sentry.example.com/1")},
+			"FEATURE_FLAGS": {Raw: pointer.To("")},
+		},
+		"payments/prd": {
+			"STRIPE_KEY": {Raw: pointer.To("sk_live_123")},
+			"LOG_LEVEL":  {Raw: pointer.To("info")},
+		},
+		"standalone/prd": {
+			"API_URL": {Raw: pointer.To("https://api.example.com")},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.URL.Query().Get("project") + "/" + r.URL.Query().Get("config")
+
+		w.Header().Set("Content-Type", "application/json")
+		var resp any
+		switch r.URL.Path {
+		case "/v3/configs/config":
+			resp = &doppler.ConfigGetResponse{APIResponse: doppler.APIResponse{Success: pointer.To(true)}, Config: configs[key]}
+		case "/v3/configs/config/secrets":
+			if _, ok := secrets[key]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				resp = &doppler.APIResponse{Success: pointer.To(false), Messages: []string{"Could not find requested config"}}
+				break
+			}
+			resp = &doppler.SecretListResponse{APIResponse: doppler.APIResponse{Success: pointer.To(true)}, Secrets: secrets[key]}
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Fatalf("Failed to write response: %v", err)
+		}
+	}))
+	// Close the server once all parallel subtests finished.
+	t.Cleanup(ts.Close)
+
+	client := &config.Client{
+		Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+			URL: pointer.To(ts.URL),
+		}),
+		Key: "test",
+	}
+
+	self := doppler.ConfigReference{Project: "frontend", Config: "prd"}
+	shared := doppler.ConfigReference{Project: "shared", Config: "prd"}
+	payments := doppler.ConfigReference{Project: "payments", Config: "prd"}
+	tests := []struct {
+		name        string
+		project     string
+		config      string
+		wantSources map[string]*doppler.SecretSource
+		wantErr     bool
+	}{
+		{
+			name:    "Config with inherited secrets",
+			project: "frontend",
+			config:  "prd",
+			wantSources: map[string]*doppler.SecretSource{
+				"API_URL":        {From: self},
+				"SENTRY_DSN":     {Inherited: true, From: shared, Candidates: []doppler.ConfigReference{shared}},
+				"STRIPE_KEY":     {Inherited: true, From: payments, Candidates: []doppler.ConfigReference{payments}},
+				"FEATURE_FLAGS":  {From: self}, // Overrides the inherited value.
+				"DOPPLER_CONFIG": {From: self},
+				"LOG_LEVEL":      {Inherited: true, Candidates: []doppler.ConfigReference{shared, payments}}, // Ambiguous.
+			},
+		},
+		{
+			name:    "Config without inheritance",
+			project: "standalone",
+			config:  "prd",
+			wantSources: map[string]*doppler.SecretSource{
+				"API_URL": {From: doppler.ConfigReference{Project: "standalone", Config: "prd"}},
+			},
+		},
+		{
+			name:    "Unknown config",
+			project: "unknown",
+			config:  "prd",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotSources, err := client.SecretSources(context.Background(), tt.project, tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unexpected error. Expected %t, got %v", tt.wantErr, err)
+			}
+			if diff := cmp.Diff(tt.wantSources, gotSources); diff != "" {
+				t.Errorf("Unexpected secret sources (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
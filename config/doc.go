@@ -17,5 +17,14 @@ Example:
 	for _, config := range configs {
 		fmt.Printf("Config: %s", config.Name)
 	}
+
+	// Only allow reading the production config's secrets from the NAT egress ranges.
+	added, removed, err := config.SyncTrustedIPs(context.Background(), "my-project", "prd", []netip.Prefix{
+		netip.MustParsePrefix("203.0.113.0/24"),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Added %v, removed %v", added, removed)
 */
 package config
@@ -1,10 +1,84 @@
 package doppler
 
+import (
+	"fmt"
+	"strings"
+)
+
+// SecretVisibility is the visibility of a secret's value in the dashboard.
+type SecretVisibility string
+
+const (
+	// SecretVisibilityMasked is the visibility of secrets whose value is hidden until revealed.
+	SecretVisibilityMasked SecretVisibility = "masked"
+
+	// SecretVisibilityUnmasked is the visibility of secrets whose value is always shown.
+	SecretVisibilityUnmasked SecretVisibility = "unmasked"
+
+	// SecretVisibilityRestricted is the visibility of secrets whose value can't be revealed after it was set.
+	SecretVisibilityRestricted SecretVisibility = "restricted"
+)
+
+// SecretChangeKind is the kind of change made to a secret.
+type SecretChangeKind string
+
+const (
+	// SecretChangeAdded is the kind of change that adds a secret.
+	SecretChangeAdded SecretChangeKind = "added"
+
+	// SecretChangeRemoved is the kind of change that removes a secret.
+	SecretChangeRemoved SecretChangeKind = "removed"
+
+	// SecretChangeUpdated is the kind of change that updates the value or visibility of a secret.
+	SecretChangeUpdated SecretChangeKind = "updated"
+)
+
 type (
-	// ConfigLogDiff represents a diff between two config logs.
+	// ConfigLogDiff represents the change made to a single secret by a config log. A secret is added if only Added is
+	// set, removed if only Removed is set, and updated otherwise.
 	ConfigLogDiff struct {
-		Name  *string `json:"name,omitempty"`  // Name of the config.
-		Added *string `json:"added,omitempty"` // Added data.
+		Name               *string           `json:"name,omitempty"`                // Name of the secret.
+		Added              *string           `json:"added,omitempty"`               // Value of the secret after the change.
+		Removed            *string           `json:"removed,omitempty"`             // Value of the secret before the change.
+		Visibility         *SecretVisibility `json:"visibility,omitempty"`          // Visibility of the secret after the change.
+		PreviousVisibility *SecretVisibility `json:"previous_visibility,omitempty"` // Visibility of the secret before the change.
+	}
+
+	// SecretChange represents a change made to a secret, as recorded in a config log.
+	SecretChange struct {
+		Secret             string            `json:"secret"`                        // Name of the secret.
+		Kind               SecretChangeKind  `json:"kind"`                          // Kind of the change.
+		Before             *string           `json:"before,omitempty"`              // Value of the secret before the change; nil if it was added.
+		After              *string           `json:"after,omitempty"`               // Value of the secret after the change; nil if it was removed.
+		PreviousVisibility *SecretVisibility `json:"previous_visibility,omitempty"` // Visibility of the secret before the change, if known.
+		Visibility         *SecretVisibility `json:"visibility,omitempty"`          // Visibility of the secret after the change, if known.
+		LogID              string            `json:"log_id"`                        // Unique identifier of the config log recording the change.
+		User               *User             `json:"user,omitempty"`                // User that made the change.
+		Rollback           bool              `json:"rollback"`                      // Whether the change was made by a rollback.
+		CreatedAt          string            `json:"created_at"`                    // Date and time of the change.
+	}
+
+	// RollbackChange represents the change a rollback makes to a secret. It carries no values, so that previews can be
+	// shown without revealing secrets.
+	RollbackChange struct {
+		Secret string           `json:"secret"` // Name of the secret.
+		Kind   SecretChangeKind `json:"kind"`   // Kind of the change.
+	}
+
+	// RollbackPlan represents the effect of rolling a config back to one of its config logs.
+	RollbackPlan struct {
+		Project string           `json:"project"` // Identifier of the project that the config belongs to.
+		Config  string           `json:"config"`  // Name of the config.
+		Locked  bool             `json:"locked"`  // Whether the config is locked.
+		Target  *ConfigLog       `json:"target"`  // The config log the config is rolled back to.
+		Logs    []*ConfigLog     `json:"logs"`    // The config logs that are rolled back, newest first.
+		Changes []RollbackChange `json:"changes"` // The changes made to the secrets, sorted by secret name.
+	}
+
+	// RollbackSummary represents the outcome of a rollback.
+	RollbackSummary struct {
+		Plan       *RollbackPlan `json:"plan"`        // The applied plan.
+		RolledBack []*ConfigLog  `json:"rolled_back"` // The config logs created by rolling back the plan's logs, in the order they were created.
 	}
 
 	// ConfigLog represents a Doppler config log.
@@ -33,9 +107,9 @@ type (
 
 	// ConfigLogGetOptions represents the options for the config log get endpoint.
 	ConfigLogGetOptions struct {
-		Project string `url:"project" json:"-"` // Identifier of the project that the config belongs to.
-		Config  string `url:"config" json:"-"`  // Name of the config.
-		ID      string `url:"log" json:"-"`     // Unique identifier of the config log.
+		Project string `url:"project" json:"-" validate:"required"` // Identifier of the project that the config belongs to.
+		Config  string `url:"config" json:"-" validate:"required"`  // Name of the config.
+		ID      string `url:"log" json:"-" validate:"required"`     // Unique identifier of the config log.
 	}
 
 	// ConfigLogListResponse represents a response from the config log list endpoint.
@@ -51,8 +125,8 @@ type (
 	// ConfigLogListOptions represents the query parameters for a config log list request.
 	ConfigLogListOptions struct {
 		ListOptions `url:",inline" json:"-"`
-		Project     string `url:"project" json:"-"` // Identifier of the project that the config belongs to.
-		Config      string `url:"config" json:"-"`  // Name of the config.
+		Project     string `url:"project" json:"-" validate:"required"` // Identifier of the project that the config belongs to.
+		Config      string `url:"config" json:"-" validate:"required"`  // Name of the config.
 	}
 
 	// ConfigLogRollbackResponse represents a response from the config log rollback endpoint.
@@ -67,8 +141,83 @@ type (
 
 	// ConfigLogRollbackOptions represents the options for the config log rollback endpoint.
 	ConfigLogRollbackOptions struct {
-		Project string `url:"project" json:"-"` // Identifier of the project that the config belongs to.
-		Config  string `url:"config" json:"-"`  // Name of the config.
-		ID      string `url:"log" json:"-"`     // Unique identifier of the config log.
+		Project string `url:"project" json:"-" validate:"required"` // Identifier of the project that the config belongs to.
+		Config  string `url:"config" json:"-" validate:"required"`  // Name of the config.
+		ID      string `url:"log" json:"-" validate:"required"`     // Unique identifier of the config log.
 	}
 )
+
+// Kind returns the kind of change the diff describes.
+func (d ConfigLogDiff) Kind() SecretChangeKind {
+	switch {
+	case d.Added != nil && d.Removed == nil:
+		return SecretChangeAdded
+	case d.Added == nil && d.Removed != nil:
+		return SecretChangeRemoved
+	default:
+		return SecretChangeUpdated
+	}
+}
+
+// String returns a human-readable summary of the rollback, listing the changed secrets without their values. If the
+// rollback failed part way, changes that weren't or were only partially applied are marked as such.
+func (s *RollbackSummary) String() string {
+	var b strings.Builder
+
+	plan := s.Plan
+	target := "unknown config log"
+	if plan.Target != nil && plan.Target.ID != nil {
+		target = "config log " + *plan.Target.ID
+		if plan.Target.CreatedAt != nil {
+			target += " (" + *plan.Target.CreatedAt + ")"
+		}
+	}
+	// The plan's logs are rolled back newest first, so the logs rolled back so far are a prefix of them.
+	applied := len(s.RolledBack)
+	if applied > len(plan.Logs) {
+		applied = len(plan.Logs)
+	}
+	if applied < len(plan.Logs) {
+		fmt.Fprintf(&b, "Partially rolled back %s/%s to %s: %d of %d config logs reverted, %d secrets planned",
+			plan.Project, plan.Config, target, applied, len(plan.Logs), len(plan.Changes))
+	} else {
+		fmt.Fprintf(&b, "Rolled back %s/%s to %s: %d of %d config logs reverted, %d secrets changed",
+			plan.Project, plan.Config, target, applied, len(plan.Logs), len(plan.Changes))
+	}
+
+	touched := func(logs []*ConfigLog) map[string]bool {
+		names := make(map[string]bool)
+		for _, log := range logs {
+			for _, diff := range log.Diff {
+				if diff.Name != nil {
+					names[*diff.Name] = true
+				}
+			}
+		}
+		return names
+	}
+	done, pending := touched(plan.Logs[:applied]), touched(plan.Logs[applied:])
+	status := func(secret string) string {
+		switch {
+		case !pending[secret]:
+			return ""
+		case done[secret]:
+			return " (partially applied)"
+		default:
+			return " (not applied)"
+		}
+	}
+
+	for _, change := range plan.Changes {
+		marker := "~"
+		switch change.Kind {
+		case SecretChangeAdded:
+			marker = "+"
+		case SecretChangeRemoved:
+			marker = "-"
+		}
+		fmt.Fprintf(&b, "\n  %s %s%s", marker, change.Secret, status(change.Secret))
+	}
+
+	return b.String()
+}
@@ -3,8 +3,33 @@ package configlog
 import (
 	"context"
 	"net/http"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"github.com/nikoksr/doppler-go"
+	configs "github.com/nikoksr/doppler-go/config"
+	"github.com/nikoksr/doppler-go/secret"
+)
+
+var (
+	// ErrHistoryUnavailable is returned by StateAt if the available config logs don't reach back to the requested time.
+	ErrHistoryUnavailable = errors.New("config history not available for the requested time")
+
+	// ErrHistoryDiverged is returned by StateAt if the config logs don't match the config's secrets, e.g. because the
+	// config was changed while its history was replayed.
+	ErrHistoryDiverged = errors.New("config logs don't match the config's secrets")
+
+	// ErrSecretRestricted is returned by StateAt if a config log changed a secret whose value is restricted, i.e. can't
+	// be read, so that its past values can't be reconstructed.
+	ErrSecretRestricted = errors.New("secret value is restricted")
+
+	// ErrLogNotFound is returned by PlanRollbackTo if the config has no config log with the given ID.
+	ErrLogNotFound = errors.New("config log not found")
+
+	// ErrConfigLocked is returned by RollbackTo if the config is locked and the rollback isn't forced.
+	ErrConfigLocked = errors.New("config is locked")
 )
 
 // Client is the client used to invoke /v3/config/logs APIs.
@@ -66,6 +91,27 @@ func List(ctx context.Context, opts *doppler.ConfigLogListOptions) ([]*doppler.C
 	return Default().List(ctx, opts)
 }
 
+// Iter returns an iterator over the config logs of a config, newest first. Pages are fetched on demand, starting at the
+// page given in opts.
+func (c Client) Iter(ctx context.Context, opts *doppler.ConfigLogListOptions) doppler.Iterator[*doppler.ConfigLog] {
+	var base doppler.ConfigLogListOptions
+	if opts != nil {
+		base = *opts
+	}
+
+	return doppler.NewPageIterator(ctx, base.ListOptions, func(ctx context.Context, page doppler.ListOptions) ([]*doppler.ConfigLog, error) {
+		pageOpts := base
+		pageOpts.ListOptions = page
+		logs, _, err := c.fetchList(ctx, &pageOpts)
+		return logs, err
+	})
+}
+
+// Iter returns an iterator over the config logs of a config using the default client.
+func Iter(ctx context.Context, opts *doppler.ConfigLogListOptions) doppler.Iterator[*doppler.ConfigLog] {
+	return Default().Iter(ctx, opts)
+}
+
 func (c Client) rollback(ctx context.Context, opts *doppler.ConfigLogRollbackOptions) (*doppler.ConfigLog, doppler.APIResponse, error) {
 	var resp doppler.ConfigLogRollbackResponse
 	err := c.Backend.Call(ctx, &doppler.Request{
@@ -87,3 +133,345 @@ func (c Client) Rollback(ctx context.Context, opts *doppler.ConfigLogRollbackOpt
 func Rollback(ctx context.Context, opts *doppler.ConfigLogRollbackOptions) (*doppler.ConfigLog, doppler.APIResponse, error) {
 	return Default().Rollback(ctx, opts)
 }
+
+// changesPerPage is the page size used to walk the config logs of a config.
+const changesPerPage = 100
+
+// stringValue returns the value of the given string pointer, or an empty string if it's nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func (c Client) changes(ctx context.Context, project, config string) (map[string][]*doppler.SecretChange, error) {
+	history := make(map[string][]*doppler.SecretChange)
+	for page := 1; ; page++ {
+		logs, _, err := c.fetchList(ctx, &doppler.ConfigLogListOptions{
+			ListOptions: doppler.ListOptions{Page: page, PerPage: changesPerPage},
+			Project:     project,
+			Config:      config,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "list config logs page %d", page)
+		}
+
+		for _, log := range logs {
+			for _, diff := range log.Diff {
+				name := stringValue(diff.Name)
+				history[name] = append(history[name], &doppler.SecretChange{
+					Secret:             name,
+					Kind:               diff.Kind(),
+					Before:             diff.Removed,
+					After:              diff.Added,
+					PreviousVisibility: diff.PreviousVisibility,
+					Visibility:         diff.Visibility,
+					LogID:              stringValue(log.ID),
+					User:               log.User,
+					Rollback:           log.Rollback != nil && *log.Rollback,
+					CreatedAt:          stringValue(log.CreatedAt),
+				})
+			}
+		}
+
+		if len(logs) < changesPerPage {
+			break
+		}
+	}
+
+	return history, nil
+}
+
+// Changes walks all config logs of a config and returns the changes made to each secret, keyed by the secret's name.
+// The changes of a secret are ordered like the config logs, newest first.
+func (c Client) Changes(ctx context.Context, project, config string) (map[string][]*doppler.SecretChange, error) {
+	return c.changes(ctx, project, config)
+}
+
+// Changes walks all config logs of a config and returns the changes made to each secret using the default client.
+func Changes(ctx context.Context, project, config string) (map[string][]*doppler.SecretChange, error) {
+	return Default().Changes(ctx, project, config)
+}
+
+// undo reverts the changes of the given config log in the given secrets. It checks the values the log left behind
+// against the secrets, so that diverged histories are detected instead of silently producing a wrong state. Value
+// changes to the given restricted secrets can't be checked nor reverted, and fail with ErrSecretRestricted.
+func undo(secrets map[string]string, restricted map[string]bool, log *doppler.ConfigLog) error {
+	for _, diff := range log.Diff {
+		name := stringValue(diff.Name)
+		current, exists := secrets[name]
+
+		if restricted[name] && (diff.Added != nil || diff.Removed != nil) {
+			return errors.Wrapf(ErrSecretRestricted, "secret %q changed by config log %q", name, stringValue(log.ID))
+		}
+
+		// The value after the change has to be the current one, unless a later change was undone already.
+		if diff.Kind() == doppler.SecretChangeRemoved {
+			if exists {
+				return errors.Wrapf(ErrHistoryDiverged, "secret %q removed by config log %q still exists", name, stringValue(log.ID))
+			}
+		} else if diff.Added != nil && (!exists || current != *diff.Added) {
+			return errors.Wrapf(ErrHistoryDiverged, "secret %q differs from its value after config log %q", name, stringValue(log.ID))
+		}
+
+		switch diff.Kind() {
+		case doppler.SecretChangeAdded:
+			delete(secrets, name)
+		case doppler.SecretChangeRemoved, doppler.SecretChangeUpdated:
+			// Visibility changes carry no values and leave the secret as is.
+			if diff.Removed != nil {
+				secrets[name] = *diff.Removed
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseLogTime parses the creation time of the given config log.
+func parseLogTime(log *doppler.ConfigLog) (time.Time, error) {
+	createdAt, err := time.Parse(time.RFC3339, stringValue(log.CreatedAt))
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "parse creation time of config log %q", stringValue(log.ID))
+	}
+
+	return createdAt, nil
+}
+
+// currentSecrets returns the raw values of the current secrets of a config, and the names of the secrets whose values
+// are restricted. Restricted secrets are left out of the values.
+func (c Client) currentSecrets(ctx context.Context, project, config string) (secrets map[string]string, restricted map[string]bool, err error) {
+	client := &secret.Client{Backend: c.Backend, Key: c.Key}
+	list, _, err := client.List(ctx, &doppler.SecretListOptions{Project: project, Config: config})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "list secrets")
+	}
+
+	secrets = make(map[string]string, len(list))
+	restricted = make(map[string]bool)
+	for name, value := range list {
+		if value == nil || value.Raw == nil {
+			restricted[name] = true
+			continue
+		}
+		secrets[name] = *value.Raw
+	}
+
+	return secrets, restricted, nil
+}
+
+func (c Client) stateAt(ctx context.Context, project, config string, t time.Time) (map[string]string, error) {
+	secrets, restricted, err := c.currentSecrets(ctx, project, config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Walk the logs from the newest to the oldest and undo every change made after t.
+	var oldest string
+	for page := 1; ; page++ {
+		logs, _, err := c.fetchList(ctx, &doppler.ConfigLogListOptions{
+			ListOptions: doppler.ListOptions{Page: page, PerPage: changesPerPage},
+			Project:     project,
+			Config:      config,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "list config logs page %d", page)
+		}
+
+		for _, log := range logs {
+			createdAt, err := parseLogTime(log)
+			if err != nil {
+				return nil, err
+			}
+			if !createdAt.After(t) {
+				return secrets, nil
+			}
+			if err := undo(secrets, restricted, log); err != nil {
+				return nil, err
+			}
+			oldest = stringValue(log.CreatedAt)
+		}
+
+		if len(logs) < changesPerPage {
+			break
+		}
+	}
+
+	// Without a log at or before t, there's no telling whether older changes exist that aren't available anymore.
+	if oldest == "" {
+		return nil, errors.Wrap(ErrHistoryUnavailable, "config has no logs")
+	}
+
+	return nil, errors.Wrapf(ErrHistoryUnavailable, "oldest available config log is from %s", oldest)
+}
+
+// StateAt reconstructs the secrets of a config at the given time by undoing all changes made since then, starting from
+// the current secrets. It returns the raw secret values, keyed by name. Secrets whose values are restricted are left
+// out.
+//
+// StateAt only reports a state it can prove: if the config logs don't reach back to t, it returns
+// ErrHistoryUnavailable, if the logs don't match the secrets, it returns ErrHistoryDiverged, and if the logs changed
+// the value of a restricted secret, it returns ErrSecretRestricted.
+func (c Client) StateAt(ctx context.Context, project, config string, t time.Time) (map[string]string, error) {
+	return c.stateAt(ctx, project, config, t)
+}
+
+// StateAt reconstructs the secrets of a config at the given time using the default client.
+func StateAt(ctx context.Context, project, config string, t time.Time) (map[string]string, error) {
+	return Default().StateAt(ctx, project, config, t)
+}
+
+// diffSecrets returns the changes turning the from secrets into the to secrets, sorted by secret name.
+func diffSecrets(from, to map[string]string) []doppler.RollbackChange {
+	var changes []doppler.RollbackChange
+	for name, value := range from {
+		target, ok := to[name]
+		switch {
+		case !ok:
+			changes = append(changes, doppler.RollbackChange{Secret: name, Kind: doppler.SecretChangeRemoved})
+		case target != value:
+			changes = append(changes, doppler.RollbackChange{Secret: name, Kind: doppler.SecretChangeUpdated})
+		}
+	}
+	for name := range to {
+		if _, ok := from[name]; !ok {
+			changes = append(changes, doppler.RollbackChange{Secret: name, Kind: doppler.SecretChangeAdded})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Secret < changes[j].Secret
+	})
+
+	return changes
+}
+
+// plan builds the plan to roll a config back to the newest config log matched by isTarget. It returns notFound if no
+// config log matches.
+func (c Client) plan(ctx context.Context, project, config string, isTarget func(*doppler.ConfigLog) (bool, error), notFound error) (*doppler.RollbackPlan, error) {
+	client := &configs.Client{Backend: c.Backend, Key: c.Key}
+	cfg, _, err := client.Get(ctx, &doppler.ConfigGetOptions{Project: project, Config: config})
+	if err != nil {
+		return nil, errors.Wrap(err, "get config")
+	}
+
+	current, restricted, err := c.currentSecrets(ctx, project, config)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &doppler.RollbackPlan{
+		Project: project,
+		Config:  config,
+		Locked:  cfg != nil && cfg.Locked != nil && *cfg.Locked,
+	}
+
+	// Undo the config logs newer than the target on a copy of the secrets, to learn the target state.
+	target := make(map[string]string, len(current))
+	for name, value := range current {
+		target[name] = value
+	}
+	for page := 1; plan.Target == nil; page++ {
+		logs, _, err := c.fetchList(ctx, &doppler.ConfigLogListOptions{
+			ListOptions: doppler.ListOptions{Page: page, PerPage: changesPerPage},
+			Project:     project,
+			Config:      config,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "list config logs page %d", page)
+		}
+
+		for _, log := range logs {
+			ok, err := isTarget(log)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				plan.Target = log
+				break
+			}
+			if err := undo(target, restricted, log); err != nil {
+				return nil, err
+			}
+			plan.Logs = append(plan.Logs, log)
+		}
+
+		if plan.Target == nil && len(logs) < changesPerPage {
+			return nil, notFound
+		}
+	}
+
+	plan.Changes = diffSecrets(current, target)
+
+	return plan, nil
+}
+
+func (c Client) planRollbackTo(ctx context.Context, project, config, id string) (*doppler.RollbackPlan, error) {
+	isTarget := func(log *doppler.ConfigLog) (bool, error) {
+		return stringValue(log.ID) == id, nil
+	}
+
+	return c.plan(ctx, project, config, isTarget, errors.Wrapf(ErrLogNotFound, "config log %q", id))
+}
+
+// PlanRollbackTo previews rolling a config back to the state right after the config log with the given ID, i.e. rolling
+// back every newer config log. The given config log itself is kept, unlike with Rollback, which undoes it. It doesn't
+// change anything, but returns the config logs that would be rolled back and the secrets that would change.
+func (c Client) PlanRollbackTo(ctx context.Context, project, config, id string) (*doppler.RollbackPlan, error) {
+	return c.planRollbackTo(ctx, project, config, id)
+}
+
+// PlanRollbackTo previews rolling a config back to the state right after the given config log using the default
+// client.
+func PlanRollbackTo(ctx context.Context, project, config, id string) (*doppler.RollbackPlan, error) {
+	return Default().PlanRollbackTo(ctx, project, config, id)
+}
+
+func (c Client) rollbackTo(ctx context.Context, project, config string, t time.Time, force bool) (*doppler.RollbackSummary, error) {
+	isTarget := func(log *doppler.ConfigLog) (bool, error) {
+		createdAt, err := parseLogTime(log)
+		if err != nil {
+			return false, err
+		}
+
+		return !createdAt.After(t), nil
+	}
+
+	plan, err := c.plan(ctx, project, config, isTarget, errors.Wrapf(ErrHistoryUnavailable, "no config log at or before %s", t.Format(time.RFC3339)))
+	if err != nil {
+		return nil, err
+	}
+	if plan.Locked && !force {
+		return nil, errors.Wrapf(ErrConfigLocked, "refusing to roll back %s/%s", project, config)
+	}
+
+	// Roll back the newest config log first, so that every rollback applies to the state its log left behind.
+	summary := &doppler.RollbackSummary{Plan: plan}
+	for _, log := range plan.Logs {
+		rolledBack, _, err := c.rollback(ctx, &doppler.ConfigLogRollbackOptions{
+			Project: project,
+			Config:  config,
+			ID:      stringValue(log.ID),
+		})
+		if err != nil {
+			return summary, errors.Wrapf(err, "roll back config log %q", stringValue(log.ID))
+		}
+		summary.RolledBack = append(summary.RolledBack, rolledBack)
+	}
+
+	return summary, nil
+}
+
+// RollbackTo rolls a config back to its state at the given time, by rolling back every config log created after it,
+// newest first. It refuses to roll back a locked config unless force is set. The returned summary describes the
+// applied changes; on failure, it covers the config logs rolled back so far.
+func (c Client) RollbackTo(ctx context.Context, project, config string, t time.Time, force bool) (*doppler.RollbackSummary, error) {
+	return c.rollbackTo(ctx, project, config, t, force)
+}
+
+// RollbackTo rolls a config back to its state at the given time using the default client.
+func RollbackTo(ctx context.Context, project, config string, t time.Time, force bool) (*doppler.RollbackSummary, error) {
+	return Default().RollbackTo(ctx, project, config, t, force)
+}
@@ -3,8 +3,12 @@ package configlog_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"sync"
 	"testing"
 	"time"
 
@@ -257,15 +261,9 @@ func TestConfigLog_List(t *testing.T) {
 					PerPage: 1,
 				},
 			},
-			wantConfigLogs: []*doppler.ConfigLog{},
-			wantResponse: doppler.APIResponse{
-				Success:    pointer.To(false),
-				Status:     "400 Bad Request",
-				StatusCode: http.StatusBadRequest,
-				Page:       pointer.To(0),
-				Messages:   []string{"Invalid page"},
-			},
-			wantErr: true,
+			wantConfigLogs: nil,
+			wantResponse:   doppler.APIResponse{}, // Rejected by the options validation; never sent.
+			wantErr:        true,
 		},
 		{
 			name:           "List config logs with invalid options error",
@@ -437,3 +435,584 @@ func TestConfigLog_Rollback(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigLog_Changes(t *testing.T) {
+	t.Parallel()
+
+	masked, unmasked := doppler.SecretVisibilityMasked, doppler.SecretVisibilityUnmasked
+	alice := &doppler.User{Email: pointer.To("alice@example.com")}
+
+	// Newest first, like the API returns them. The filler logs push the oldest log onto a second page.
+	logs := []*doppler.ConfigLog{
+		{
+			ID:        pointer.To("log-3"),
+			User:      alice,
+			CreatedAt: pointer.To("2023-01-03T00:00:00.000Z"),
+			Diff: []doppler.ConfigLogDiff{
+				{Name: pointer.To("API_KEY"), Removed: pointer.To("v2")},
+				{Name: pointer.To("DB_URL"), Visibility: &masked, PreviousVisibility: &unmasked},
+			},
+		},
+		{
+			ID:        pointer.To("log-2"),
+			User:      alice,
+			Rollback:  pointer.To(true),
+			CreatedAt: pointer.To("2023-01-02T00:00:00.000Z"),
+			Diff: []doppler.ConfigLogDiff{
+				{Name: pointer.To("API_KEY"), Added: pointer.To("v2"), Removed: pointer.To("v1")},
+			},
+		},
+	}
+	for i := 0; i < 99; i++ {
+		logs = append(logs, &doppler.ConfigLog{ID: pointer.To(fmt.Sprintf("filler-%d", i))})
+	}
+	logs = append(logs, &doppler.ConfigLog{
+		ID:        pointer.To("log-1"),
+		User:      alice,
+		CreatedAt: pointer.To("2023-01-01T00:00:00.000Z"),
+		Diff: []doppler.ConfigLogDiff{
+			{Name: pointer.To("API_KEY"), Added: pointer.To("v1")},
+			{Name: pointer.To("DB_URL"), Added: pointer.To("postgres://"), Visibility: &unmasked},
+		},
+	})
+
+	var pages []int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v3/configs/config/logs" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		q := r.URL.Query()
+		page, _ := strconv.Atoi(q.Get("page"))
+		perPage, _ := strconv.Atoi(q.Get("per_page"))
+		pages = append(pages, page)
+
+		start, end := (page-1)*perPage, page*perPage
+		if start > len(logs) {
+			start = len(logs)
+		}
+		if end > len(logs) {
+			end = len(logs)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(&doppler.ConfigLogListResponse{
+			APIResponse: doppler.APIResponse{Success: pointer.To(true), Page: pointer.To(page)},
+			ConfigLogs:  logs[start:end],
+		})
+		if err != nil {
+			t.Fatalf("Failed to write response: %v", err)
+		}
+	}))
+	defer ts.Close()
+
+	client := &configlog.Client{
+		Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+			URL: pointer.To(ts.URL),
+		}),
+		Key: "test",
+	}
+
+	got, err := client.Changes(context.Background(), "backend", "prd")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := map[string][]*doppler.SecretChange{
+		"API_KEY": {
+			{Secret: "API_KEY", Kind: doppler.SecretChangeRemoved, Before: pointer.To("v2"), LogID: "log-3", User: alice, CreatedAt: "2023-01-03T00:00:00.000Z"},
+			{Secret: "API_KEY", Kind: doppler.SecretChangeUpdated, Before: pointer.To("v1"), After: pointer.To("v2"), LogID: "log-2", User: alice, Rollback: true, CreatedAt: "2023-01-02T00:00:00.000Z"},
+			{Secret: "API_KEY", Kind: doppler.SecretChangeAdded, After: pointer.To("v1"), LogID: "log-1", User: alice, CreatedAt: "2023-01-01T00:00:00.000Z"},
+		},
+		"DB_URL": {
+			{Secret: "DB_URL", Kind: doppler.SecretChangeUpdated, PreviousVisibility: &unmasked, Visibility: &masked, LogID: "log-3", User: alice, CreatedAt: "2023-01-03T00:00:00.000Z"},
+			{Secret: "DB_URL", Kind: doppler.SecretChangeAdded, After: pointer.To("postgres://"), Visibility: &unmasked, LogID: "log-1", User: alice, CreatedAt: "2023-01-01T00:00:00.000Z"},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected changes (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]int{1, 2}, pages); diff != "" {
+		t.Errorf("Unexpected pages (-want +got):\n%s", diff)
+	}
+}
+
+func TestConfigLog_ChangesError(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(&doppler.APIResponse{Success: pointer.To(false), Messages: []string{"Could not find requested config"}})
+	}))
+	defer ts.Close()
+
+	client := &configlog.Client{
+		Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+			URL: pointer.To(ts.URL),
+		}),
+		Key: "test",
+	}
+
+	if _, err := client.Changes(context.Background(), "backend", "unknown"); err == nil {
+		t.Fatal("Expected an error")
+	}
+}
+
+// historyServer mocks the config, secrets list and config log endpoints of a single config.
+type historyServer struct {
+	t          *testing.T
+	secrets    map[string]string
+	restricted []string // Secrets listed without a value.
+	logs       []*doppler.ConfigLog
+	locked     bool
+	failOn     string
+
+	mu         sync.Mutex
+	rolledBack []string
+}
+
+func (s *historyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	var resp any
+	switch r.URL.Path {
+	case "/v3/configs/config":
+		resp = &doppler.ConfigGetResponse{
+			APIResponse: doppler.APIResponse{Success: pointer.To(true)},
+			Config:      &doppler.Config{Name: pointer.To("prd"), Locked: pointer.To(s.locked)},
+		}
+	case "/v3/configs/config/secrets":
+		values := make(map[string]*doppler.SecretValue, len(s.secrets))
+		for name, raw := range s.secrets {
+			values[name] = &doppler.SecretValue{Raw: pointer.To(raw), Computed: pointer.To(raw)}
+		}
+		for _, name := range s.restricted {
+			values[name] = &doppler.SecretValue{}
+		}
+		resp = &doppler.SecretListResponse{APIResponse: doppler.APIResponse{Success: pointer.To(true)}, Secrets: values}
+	case "/v3/configs/config/logs":
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		start, end := (page-1)*perPage, page*perPage
+		if start > len(s.logs) {
+			start = len(s.logs)
+		}
+		if end > len(s.logs) {
+			end = len(s.logs)
+		}
+		resp = &doppler.ConfigLogListResponse{APIResponse: doppler.APIResponse{Success: pointer.To(true)}, ConfigLogs: s.logs[start:end]}
+	case "/v3/configs/config/logs/log/rollback":
+		id := r.URL.Query().Get("log")
+		if r.Method != http.MethodPost {
+			s.t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if id == s.failOn {
+			w.WriteHeader(http.StatusInternalServerError)
+			resp = &doppler.APIResponse{Success: pointer.To(false), Messages: []string{"Rollback failed"}}
+			break
+		}
+		s.rolledBack = append(s.rolledBack, id)
+		resp = &doppler.ConfigLogRollbackResponse{
+			APIResponse: doppler.APIResponse{Success: pointer.To(true)},
+			ConfigLog:   &doppler.ConfigLog{ID: pointer.To("rollback-" + id), Rollback: pointer.To(true)},
+		}
+	default:
+		s.t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+	}
+
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		s.t.Fatalf("Failed to write response: %v", err)
+	}
+}
+
+// testHistory returns the current secrets and the config logs of a config, newest first:
+//
+//	day 3: API_KEY removed, DB_URL masked
+//	day 2: API_KEY rotated from v1 to v2, PORT added
+//	day 1: API_KEY and DB_URL added
+func testHistory() (map[string]string, []*doppler.ConfigLog) {
+	masked, unmasked := doppler.SecretVisibilityMasked, doppler.SecretVisibilityUnmasked
+	current := map[string]string{"DB_URL": "postgres://db.internal:5432", "PORT": "8080", "DOPPLER_CONFIG": "prd"}
+	logs := []*doppler.ConfigLog{
+		{
+			ID:        pointer.To("log-3"),
+			CreatedAt: pointer.To("2023-01-03T00:00:00.000Z"),
+			Diff: []doppler.ConfigLogDiff{
+				{Name: pointer.To("API_KEY"), Removed: pointer.To("v2")},
+				{Name: pointer.To("DB_URL"), Visibility: &masked, PreviousVisibility: &unmasked},
+			},
+		},
+		{
+			ID:        pointer.To("log-2"),
+			CreatedAt: pointer.To("2023-01-02T00:00:00.000Z"),
+			Diff: []doppler.ConfigLogDiff{
+				{Name: pointer.To("API_KEY"), Added: pointer.To("v2"), Removed: pointer.To("sk_live_0123456789abcdef")},
+				{Name: pointer.To("PORT"), Added: pointer.To("8080")},
+			},
+		},
+		{
+			ID:        pointer.To("log-1"),
+			CreatedAt: pointer.To("2023-01-01T00:00:00.000Z"),
+			Diff: []doppler.ConfigLogDiff{
+				{Name: pointer.To("API_KEY"), Added: pointer.To("sk_live_0123456789abcdef")},
+				{Name: pointer.To("DB_URL"), Added: pointer.To("postgres://db.internal:5432")},
+			},
+		},
+	}
+
+	return current, logs
+}
+
+func TestConfigLog_StateAt(t *testing.T) {
+	t.Parallel()
+
+	current, logs := testHistory()
+	day := func(d int, hour int) time.Time { return time.Date(2023, 1, d, hour, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name        string
+		secrets     map[string]string
+		restricted  []string
+		logs        []*doppler.ConfigLog
+		at          time.Time
+		wantSecrets map[string]string
+		wantErr     error
+	}{
+		{
+			name:        "State after the newest log",
+			secrets:     current,
+			logs:        logs,
+			at:          day(4, 0),
+			wantSecrets: current,
+		},
+		{
+			name:        "State between logs",
+			secrets:     current,
+			logs:        logs,
+			at:          day(2, 12),
+			wantSecrets: map[string]string{"API_KEY": "v2", "DB_URL": "postgres://db.internal:5432", "PORT": "8080", "DOPPLER_CONFIG": "prd"},
+		},
+		{
+			name:        "State at the time of a log",
+			secrets:     current,
+			logs:        logs,
+			at:          day(1, 0),
+			wantSecrets: map[string]string{"API_KEY": "sk_live_0123456789abcdef", "DB_URL": "postgres://db.internal:5432", "DOPPLER_CONFIG": "prd"},
+		},
+		{
+			name:    "State before the oldest log",
+			secrets: current,
+			logs:    logs,
+			at:      day(1, 0).Add(-time.Second),
+			wantErr: configlog.ErrHistoryUnavailable,
+		},
+		{
+			name:    "State of config without logs",
+			secrets: current,
+			logs:    nil,
+			at:      day(1, 0),
+			wantErr: configlog.ErrHistoryUnavailable,
+		},
+		{
+			name:    "State of config changed after its logs",
+			secrets: map[string]string{"DB_URL": "postgres://db.internal:5432", "PORT": "9090", "DOPPLER_CONFIG": "prd"},
+			logs:    logs,
+			at:      day(1, 12),
+			wantErr: configlog.ErrHistoryDiverged,
+		},
+		{
+			name:    "State of config re-adding a removed secret without log",
+			secrets: map[string]string{"API_KEY": "v3", "DB_URL": "postgres://db.internal:5432", "PORT": "8080"},
+			logs:    logs,
+			at:      day(2, 12),
+			wantErr: configlog.ErrHistoryDiverged,
+		},
+		{
+			name:        "State of config with an unchanged restricted secret",
+			secrets:     current,
+			restricted:  []string{"SIGNING_KEY"},
+			logs:        logs,
+			at:          day(2, 12),
+			wantSecrets: map[string]string{"API_KEY": "v2", "DB_URL": "postgres://db.internal:5432", "PORT": "8080", "DOPPLER_CONFIG": "prd"},
+		},
+		{
+			name:       "State of config with a changed restricted secret",
+			secrets:    map[string]string{"DB_URL": "postgres://db.internal:5432", "DOPPLER_CONFIG": "prd"},
+			restricted: []string{"PORT"},
+			logs:       logs,
+			at:         day(1, 12),
+			wantErr:    configlog.ErrSecretRestricted,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := httptest.NewServer(&historyServer{t: t, secrets: tt.secrets, restricted: tt.restricted, logs: tt.logs})
+			defer ts.Close()
+
+			client := &configlog.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotSecrets, err := client.StateAt(context.Background(), "backend", "prd", tt.at)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Unexpected error. Expected %v, got %v", tt.wantErr, err)
+			}
+			if diff := cmp.Diff(tt.wantSecrets, gotSecrets); diff != "" {
+				t.Errorf("Unexpected secrets (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigLog_PlanRollbackTo(t *testing.T) {
+	t.Parallel()
+
+	current, logs := testHistory()
+
+	tests := []struct {
+		name     string
+		id       string
+		locked   bool
+		wantPlan *doppler.RollbackPlan
+		wantErr  error
+	}{
+		{
+			name: "Plan rollback to oldest log",
+			id:   "log-1",
+			wantPlan: &doppler.RollbackPlan{
+				Project: "backend",
+				Config:  "prd",
+				Target:  logs[2],
+				Logs:    logs[:2],
+				Changes: []doppler.RollbackChange{
+					{Secret: "API_KEY", Kind: doppler.SecretChangeAdded},
+					{Secret: "PORT", Kind: doppler.SecretChangeRemoved},
+				},
+			},
+		},
+		{
+			name:   "Plan rollback of locked config to newest log",
+			id:     "log-3",
+			locked: true,
+			wantPlan: &doppler.RollbackPlan{
+				Project: "backend",
+				Config:  "prd",
+				Locked:  true,
+				Target:  logs[0],
+			},
+		},
+		{
+			name:    "Plan rollback to unknown log",
+			id:      "log-0",
+			wantErr: configlog.ErrLogNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := &historyServer{t: t, secrets: current, logs: logs, locked: tt.locked}
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			client := &configlog.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotPlan, err := client.PlanRollbackTo(context.Background(), "backend", "prd", tt.id)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Unexpected error. Expected %v, got %v", tt.wantErr, err)
+			}
+			if diff := cmp.Diff(tt.wantPlan, gotPlan); diff != "" {
+				t.Errorf("Unexpected plan (-want +got):\n%s", diff)
+			}
+			if len(srv.rolledBack) > 0 {
+				t.Errorf("Expected no rollbacks, got %v", srv.rolledBack)
+			}
+		})
+	}
+}
+
+func TestConfigLog_RollbackTo(t *testing.T) {
+	t.Parallel()
+
+	current, logs := testHistory()
+	errAPI := errors.New("any API error")
+
+	tests := []struct {
+		name           string
+		at             time.Time
+		locked         bool
+		force          bool
+		failOn         string
+		wantRolledBack []string
+		wantSummary    string
+		wantErr        error
+	}{
+		{
+			name:           "Roll back to time between logs",
+			at:             time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+			wantRolledBack: []string{"log-3", "log-2"},
+			wantSummary: "Rolled back backend/prd to config log log-1 (2023-01-01T00:00:00.000Z): 2 of 2 config logs reverted, 2 secrets changed\n" +
+				"  + API_KEY\n" +
+				"  - PORT",
+		},
+		{
+			name:           "Roll back to time after newest log",
+			at:             time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC),
+			wantRolledBack: nil,
+			wantSummary:    "Rolled back backend/prd to config log log-3 (2023-01-03T00:00:00.000Z): 0 of 0 config logs reverted, 0 secrets changed",
+		},
+		{
+			name:    "Refuse to roll back locked config",
+			at:      time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+			locked:  true,
+			wantErr: configlog.ErrConfigLocked,
+		},
+		{
+			name:           "Force roll back of locked config",
+			at:             time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC),
+			locked:         true,
+			force:          true,
+			wantRolledBack: []string{"log-3"},
+			wantSummary: "Rolled back backend/prd to config log log-2 (2023-01-02T00:00:00.000Z): 1 of 1 config logs reverted, 1 secrets changed\n" +
+				"  + API_KEY",
+		},
+		{
+			name:    "Roll back to time before history",
+			at:      time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC),
+			wantErr: configlog.ErrHistoryUnavailable,
+		},
+		{
+			name:           "Roll back with failing rollback",
+			at:             time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+			failOn:         "log-2",
+			wantRolledBack: []string{"log-3"},
+			wantSummary: "Partially rolled back backend/prd to config log log-1 (2023-01-01T00:00:00.000Z): 1 of 2 config logs reverted, 2 secrets planned\n" +
+				"  + API_KEY (partially applied)\n" +
+				"  - PORT (not applied)",
+			wantErr: errAPI,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := &historyServer{t: t, secrets: current, logs: logs, locked: tt.locked, failOn: tt.failOn}
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			client := &configlog.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotSummary, err := client.RollbackTo(context.Background(), "backend", "prd", tt.at, tt.force)
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Fatalf("Unexpected error. Expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil && tt.wantErr != errAPI && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Unexpected error. Expected %v, got %v", tt.wantErr, err)
+			}
+			if diff := cmp.Diff(tt.wantRolledBack, srv.rolledBack); diff != "" {
+				t.Errorf("Unexpected rollbacks (-want +got):\n%s", diff)
+			}
+
+			var gotText string
+			if gotSummary != nil {
+				gotText = gotSummary.String()
+			}
+			if diff := cmp.Diff(tt.wantSummary, gotText); diff != "" {
+				t.Errorf("Unexpected summary (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigLog_Iter(t *testing.T) {
+	t.Parallel()
+
+	current, logs := testHistory()
+	ts := httptest.NewServer(&historyServer{t: t, secrets: current, logs: logs})
+	t.Cleanup(ts.Close)
+
+	client := &configlog.Client{
+		Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+			URL: pointer.To(ts.URL),
+		}),
+		Key: "test",
+	}
+
+	var wantIDs []string
+	for _, log := range logs {
+		wantIDs = append(wantIDs, *log.ID)
+	}
+
+	tests := []struct {
+		name    string
+		options *doppler.ConfigLogListOptions
+		wantIDs []string
+		wantErr bool
+	}{
+		{
+			name: "Iterate over multiple pages",
+			options: &doppler.ConfigLogListOptions{
+				ListOptions: doppler.ListOptions{PerPage: 2},
+				Project:     "backend",
+				Config:      "prd",
+			},
+			wantIDs: wantIDs,
+		},
+		{
+			name:    "Iterate with invalid options error",
+			options: nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			it := client.Iter(context.Background(), tt.options)
+			var gotIDs []string
+			var err error
+			for {
+				var log *doppler.ConfigLog
+				log, err = it.Next()
+				if err != nil {
+					break
+				}
+				gotIDs = append(gotIDs, *log.ID)
+			}
+
+			if gotErr := !errors.Is(err, doppler.ErrIteratorDone); gotErr != tt.wantErr {
+				t.Fatalf("Unexpected error. Expected %t, got %v", tt.wantErr, err)
+			}
+			if diff := cmp.Diff(tt.wantIDs, gotIDs); diff != "" {
+				t.Errorf("Unexpected config logs (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
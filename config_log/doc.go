@@ -15,5 +15,33 @@ Example:
 	for _, log := range configLogs {
 		fmt.Printf("%+v\n", log)
 	}
+
+	// Print the change history of a single secret
+	history, err := configlog.Changes(ctx, "my-project", "prd")
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, change := range history["DB_PASSWORD"] {
+		fmt.Printf("%s: %s by %s\n", change.CreatedAt, change.Kind, *change.User.Email)
+	}
+
+	// Reconstruct the secrets of a config as they were during an incident
+	secrets, err := configlog.StateAt(ctx, "my-project", "prd", incidentStart)
+	if errors.Is(err, configlog.ErrHistoryUnavailable) {
+		log.Fatal("config logs don't reach back far enough")
+	}
+
+	// Preview a rollback, then roll the config back to the time before the incident
+	plan, err := configlog.PlanRollbackTo(ctx, "my-project", "prd", "log-id")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%d secrets would change\n", len(plan.Changes))
+
+	summary, err := configlog.RollbackTo(ctx, "my-project", "prd", incidentStart, false)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(summary)
 */
 package configlog
@@ -0,0 +1,28 @@
+package doppler
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestConfigLogDiff_Kind(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]SecretChangeKind{
+		`{"name":"A","added":"1"}`:                                            SecretChangeAdded,
+		`{"name":"A","added":""}`:                                             SecretChangeAdded,
+		`{"name":"A","removed":"1"}`:                                          SecretChangeRemoved,
+		`{"name":"A","added":"2","removed":"1"}`:                              SecretChangeUpdated,
+		`{"name":"A","visibility":"masked","previous_visibility":"unmasked"}`: SecretChangeUpdated,
+	}
+
+	for raw, want := range cases {
+		var diff ConfigLogDiff
+		if err := json.Unmarshal([]byte(raw), &diff); err != nil {
+			t.Fatalf("Failed to decode %s: %v", raw, err)
+		}
+		if got := diff.Kind(); got != want {
+			t.Errorf("Kind of %s: expected %q, got %q", raw, want, got)
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package doppler
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// EnvToken is the name of the environment variable holding the API key. It's the same one used by the Doppler
+	// CLI.
+	EnvToken = "DOPPLER_TOKEN"
+
+	// cliConfigFile is the path of the Doppler CLI's config file, relative to the user's home directory.
+	cliConfigFile = ".doppler/.doppler.yaml"
+)
+
+// ErrNoCredentials is returned by a CredentialProvider if it has no API key to offer.
+var ErrNoCredentials = errors.New("no credentials found")
+
+// CredentialProvider provides the API key used to authenticate requests. The backend consults it for every request
+// that doesn't carry its own key, so implementations are free to rotate the key at any time. Implementations must be
+// safe for concurrent use.
+type CredentialProvider interface {
+	// Key returns the API key to use. If the provider has no key to offer, it returns ErrNoCredentials.
+	Key(ctx context.Context) (string, error)
+}
+
+// CredentialInvalidator may be implemented by a CredentialProvider which caches keys. The backend calls Invalidate
+// with the rejected key when the API responds with 401 Unauthorized, before resolving the key a second time.
+type CredentialInvalidator interface {
+	Invalidate(key string)
+}
+
+// StaticCredentials is a CredentialProvider that always returns the same API key.
+type StaticCredentials string
+
+// Compile-time check to ensure that StaticCredentials implements the CredentialProvider interface.
+var _ CredentialProvider = StaticCredentials("")
+
+// Key returns the static API key.
+func (c StaticCredentials) Key(_ context.Context) (string, error) {
+	if c == "" {
+		return "", ErrNoCredentials
+	}
+
+	return string(c), nil
+}
+
+// EnvCredentials is a CredentialProvider that reads the API key from an environment variable. The variable is read
+// on every call.
+type EnvCredentials struct {
+	// Name is the name of the environment variable. If empty, EnvToken is used.
+	Name string
+}
+
+// Compile-time check to ensure that EnvCredentials implements the CredentialProvider interface.
+var _ CredentialProvider = (*EnvCredentials)(nil)
+
+// Key returns the API key held by the environment variable.
+func (c *EnvCredentials) Key(_ context.Context) (string, error) {
+	name := c.Name
+	if name == "" {
+		name = EnvToken
+	}
+
+	key := strings.TrimSpace(os.Getenv(name))
+	if key == "" {
+		return "", ErrNoCredentials
+	}
+
+	return key, nil
+}
+
+// cachedFile reads a file and caches its content until the file's modification time or size changes.
+type cachedFile struct {
+	mu      sync.Mutex
+	path    string
+	modTime time.Time
+	size    int64
+	content []byte
+}
+
+// read returns the file's content, re-reading the file only if it changed since the last read.
+func (f *cachedFile) read(path string) ([]byte, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.content != nil && f.path == path && f.modTime.Equal(info.ModTime()) && f.size == info.Size() {
+		return f.content, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f.path = path
+	f.modTime = info.ModTime()
+	f.size = info.Size()
+	f.content = content
+
+	return content, nil
+}
+
+// reset drops the cached content, forcing the next read to hit the file system.
+func (f *cachedFile) reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.content = nil
+}
+
+// FileCredentials is a CredentialProvider that reads the API key from a file, e.g. a mounted Kubernetes secret. The
+// file is re-read whenever it changes.
+type FileCredentials struct {
+	// Path is the path of the file holding the API key. Leading and trailing whitespace is ignored.
+	Path string
+
+	file cachedFile
+}
+
+// Compile-time check to ensure that FileCredentials implements the CredentialProvider and CredentialInvalidator
+// interfaces.
+var (
+	_ CredentialProvider    = (*FileCredentials)(nil)
+	_ CredentialInvalidator = (*FileCredentials)(nil)
+)
+
+// Key returns the API key held by the file.
+func (c *FileCredentials) Key(_ context.Context) (string, error) {
+	content, err := c.file.read(c.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", ErrNoCredentials
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "read credentials file")
+	}
+
+	key := strings.TrimSpace(string(content))
+	if key == "" {
+		return "", ErrNoCredentials
+	}
+
+	return key, nil
+}
+
+// Invalidate drops the cached key, forcing the file to be read again.
+func (c *FileCredentials) Invalidate(_ string) {
+	c.file.reset()
+}
+
+// CLICredentials is a CredentialProvider that reads the API key from the Doppler CLI's config file. The CLI stores
+// tokens per scope, which is a directory; the token of the most specific scope containing Dir is used. The file is
+// re-read whenever it changes.
+type CLICredentials struct {
+	// Path is the path of the CLI's config file. If empty, ~/.doppler/.doppler.yaml is used.
+	Path string
+
+	// Dir is the directory to look up the token for. If empty, the current working directory is used.
+	Dir string
+
+	file cachedFile
+}
+
+// Compile-time check to ensure that CLICredentials implements the CredentialProvider and CredentialInvalidator
+// interfaces.
+var (
+	_ CredentialProvider    = (*CLICredentials)(nil)
+	_ CredentialInvalidator = (*CLICredentials)(nil)
+)
+
+// cliConfig is the subset of the Doppler CLI's config file that is relevant to the SDK.
+type cliConfig struct {
+	Scoped map[string]struct {
+		Token string `yaml:"token"`
+	} `yaml:"scoped"`
+}
+
+// Key returns the API key of the most specific scope containing the configured directory.
+func (c *CLICredentials) Key(_ context.Context) (string, error) {
+	path := c.Path
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", ErrNoCredentials
+		}
+		path = filepath.Join(home, cliConfigFile)
+	}
+
+	dir := c.Dir
+	if dir == "" {
+		var err error
+		if dir, err = os.Getwd(); err != nil {
+			return "", errors.Wrap(err, "get working directory")
+		}
+	}
+
+	content, err := c.file.read(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", ErrNoCredentials
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "read cli config file")
+	}
+
+	var config cliConfig
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return "", errors.Wrap(err, "parse cli config file")
+	}
+
+	// Find the most specific scope with a token.
+	var key, bestScope string
+	for scope, values := range config.Scoped {
+		if values.Token == "" || !scopeContains(scope, dir) {
+			continue
+		}
+		if key == "" || len(scope) > len(bestScope) {
+			key, bestScope = values.Token, scope
+		}
+	}
+
+	if key == "" {
+		return "", ErrNoCredentials
+	}
+
+	return key, nil
+}
+
+// Invalidate drops the cached config file, forcing it to be read again.
+func (c *CLICredentials) Invalidate(_ string) {
+	c.file.reset()
+}
+
+// scopeContains reports whether the CLI scope contains the given directory.
+func scopeContains(scope, dir string) bool {
+	scope = filepath.Clean(scope)
+	dir = filepath.Clean(dir)
+
+	if scope == string(filepath.Separator) || scope == dir {
+		return true
+	}
+
+	return strings.HasPrefix(dir, scope+string(filepath.Separator))
+}
+
+// ChainCredentials is a CredentialProvider that returns the key of the first provider in the chain which has one.
+// Providers returning ErrNoCredentials are skipped, any other error aborts the lookup.
+type ChainCredentials []CredentialProvider
+
+// Compile-time check to ensure that ChainCredentials implements the CredentialProvider and CredentialInvalidator
+// interfaces.
+var (
+	_ CredentialProvider    = ChainCredentials(nil)
+	_ CredentialInvalidator = ChainCredentials(nil)
+)
+
+// Key returns the key of the first provider in the chain which has one.
+func (c ChainCredentials) Key(ctx context.Context) (string, error) {
+	for _, provider := range c {
+		key, err := provider.Key(ctx)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+
+		return key, nil
+	}
+
+	return "", ErrNoCredentials
+}
+
+// Invalidate invalidates all providers in the chain which cache keys.
+func (c ChainCredentials) Invalidate(key string) {
+	for _, provider := range c {
+		if invalidator, ok := provider.(CredentialInvalidator); ok {
+			invalidator.Invalidate(key)
+		}
+	}
+}
+
+// DefaultCredentials returns a provider chain that mirrors the Doppler CLI's lookup order: the DOPPLER_TOKEN
+// environment variable first, then the CLI's config file.
+func DefaultCredentials() CredentialProvider {
+	return ChainCredentials{
+		&EnvCredentials{},
+		&CLICredentials{},
+	}
+}
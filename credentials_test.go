@@ -0,0 +1,217 @@
+package doppler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nikoksr/doppler-go/pointer"
+)
+
+func TestStaticCredentials(t *testing.T) {
+	t.Parallel()
+
+	key, err := StaticCredentials("dp.st.test").Key(context.Background())
+	if err != nil || key != "dp.st.test" {
+		t.Errorf("Key() = %q, %v, want %q, nil", key, err, "dp.st.test")
+	}
+
+	_, err = StaticCredentials("").Key(context.Background())
+	if !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("Key() error = %v, want %v", err, ErrNoCredentials)
+	}
+}
+
+func TestEnvCredentials(t *testing.T) {
+	t.Setenv("DOPPLER_GO_TEST_TOKEN", " dp.st.env \n")
+
+	provider := &EnvCredentials{Name: "DOPPLER_GO_TEST_TOKEN"}
+	key, err := provider.Key(context.Background())
+	if err != nil || key != "dp.st.env" {
+		t.Errorf("Key() = %q, %v, want %q, nil", key, err, "dp.st.env")
+	}
+
+	t.Setenv("DOPPLER_GO_TEST_TOKEN", "")
+	if _, err = provider.Key(context.Background()); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("Key() error = %v, want %v", err, ErrNoCredentials)
+	}
+}
+
+func TestFileCredentials(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "token")
+	provider := &FileCredentials{Path: path}
+
+	// Missing file
+	if _, err := provider.Key(context.Background()); !errors.Is(err, ErrNoCredentials) {
+		t.Fatalf("Key() error = %v, want %v", err, ErrNoCredentials)
+	}
+
+	// Initial key
+	writeFile(t, path, "dp.st.first\n", time.Unix(1000, 0))
+	if key, err := provider.Key(context.Background()); err != nil || key != "dp.st.first" {
+		t.Fatalf("Key() = %q, %v, want %q, nil", key, err, "dp.st.first")
+	}
+
+	// Rotated key; the file changed, so it must be read again.
+	writeFile(t, path, "dp.st.second\n", time.Unix(2000, 0))
+	if key, err := provider.Key(context.Background()); err != nil || key != "dp.st.second" {
+		t.Fatalf("Key() = %q, %v, want %q, nil", key, err, "dp.st.second")
+	}
+}
+
+func TestCLICredentials(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), ".doppler.yaml")
+	writeFile(t, path, `
+scoped:
+    /:
+        token: dp.ct.root
+        api-host: https://api.doppler.com
+    /home/user/project:
+        token: dp.st.project
+        enclave.project: example
+        enclave.config: dev
+    /home/user/other:
+        enclave.project: other
+`, time.Unix(1000, 0))
+
+	cases := []struct {
+		name    string
+		dir     string
+		wantKey string
+	}{
+		{name: "root scope", dir: "/tmp", wantKey: "dp.ct.root"},
+		{name: "exact scope", dir: "/home/user/project", wantKey: "dp.st.project"},
+		{name: "nested scope", dir: "/home/user/project/cmd/app", wantKey: "dp.st.project"},
+		{name: "sibling with common prefix", dir: "/home/user/project-two", wantKey: "dp.ct.root"},
+		{name: "scope without token", dir: "/home/user/other", wantKey: "dp.ct.root"},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := &CLICredentials{Path: path, Dir: tc.dir}
+			key, err := provider.Key(context.Background())
+			if err != nil {
+				t.Fatalf("Key() returned an error: %v", err)
+			}
+			if key != tc.wantKey {
+				t.Errorf("Key() = %q, want %q", key, tc.wantKey)
+			}
+		})
+	}
+
+	// Missing file
+	provider := &CLICredentials{Path: filepath.Join(t.TempDir(), "missing.yaml"), Dir: "/"}
+	if _, err := provider.Key(context.Background()); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("Key() error = %v, want %v", err, ErrNoCredentials)
+	}
+}
+
+func TestChainCredentials(t *testing.T) {
+	t.Parallel()
+
+	chain := ChainCredentials{StaticCredentials(""), StaticCredentials("dp.st.second"), StaticCredentials("dp.st.third")}
+	if key, err := chain.Key(context.Background()); err != nil || key != "dp.st.second" {
+		t.Errorf("Key() = %q, %v, want %q, nil", key, err, "dp.st.second")
+	}
+
+	if _, err := (ChainCredentials{}).Key(context.Background()); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("Key() error = %v, want %v", err, ErrNoCredentials)
+	}
+}
+
+func TestBackendImplementation_Credentials(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "token")
+	writeFile(t, path, "dp.st.old", time.Unix(1000, 0))
+
+	// Create a fake HTTP server that only accepts the new key. When it sees the old key, it rotates the key on disk
+	// and rejects the request, just like a rotated Kubernetes secret would behave.
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		key, _, _ := r.BasicAuth()
+		if key != "dp.st.new" {
+			writeFile(t, path, "dp.st.new", time.Unix(1000, 0)) // Same modification time; forces invalidation.
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	backend := GetBackendWithConfig(&BackendConfig{
+		URL:         pointer.To(server.URL),
+		Credentials: &FileCredentials{Path: path},
+	})
+
+	// The provider takes precedence over the request's key.
+	resp, err := backend.CallRaw(context.Background(), &Request{Method: http.MethodGet, Path: "/v3/me", Key: "dp.st.static"})
+	if err != nil {
+		t.Fatalf("CallRaw() returned an error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+}
+
+func TestBackendImplementation_CredentialsError(t *testing.T) {
+	t.Parallel()
+
+	backend := GetBackendWithConfig(&BackendConfig{
+		URL:         pointer.To("http://localhost"),
+		Credentials: ChainCredentials{},
+	})
+
+	err := backend.Call(context.Background(), &Request{Method: http.MethodGet, Path: "/v3/me"}, nil)
+	if !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("Call() error = %v, want %v", err, ErrNoCredentials)
+	}
+}
+
+func TestGetBackend_Credentials(t *testing.T) {
+	// Not parallel; modifies the package level credentials.
+	Credentials = StaticCredentials("dp.st.provided")
+	defer func() { Credentials = nil }()
+
+	// Default clients pass the package level key with every request.
+	backend, ok := GetBackend().(*backendImplementation)
+	if !ok {
+		t.Fatalf("Expected *backendImplementation, got %T", GetBackend())
+	}
+	key, err := backend.resolveKey(context.Background(), &Request{Key: "dp.st.static"})
+	if err != nil {
+		t.Fatalf("resolveKey() returned an error: %v", err)
+	}
+	if key != "dp.st.provided" {
+		t.Errorf("Expected key %q, got %q", "dp.st.provided", key)
+	}
+}
+
+// writeFile writes the content to the file at path and sets its modification time.
+func writeFile(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("Failed to set file times: %v", err)
+	}
+}
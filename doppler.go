@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -44,9 +45,15 @@ const (
 	headerRateLimitReset = "X-RateLimit-Reset"
 )
 
-// Key is the API key used to authenticate with the API
+// Key is the API key used to authenticate with the API. It's only read by the package level functions and Default
+// constructors of the service packages. Programs using more than one key, or changing the key concurrently, should use
+// per client keys instead, e.g. via the client package or BackendConfig.Key.
 var Key string
 
+// Credentials provides the API key used by the package level functions and Default constructors of the service
+// packages. If set, it takes precedence over Key.
+var Credentials CredentialProvider
+
 // BackendConfig is the configuration for the backend.
 type BackendConfig struct {
 	// Client is the HTTP client to use for requests. If nil, a default client will be used.
@@ -57,6 +64,23 @@ type BackendConfig struct {
 
 	// Logger is the logger to use for logging. If nil, a noop logger will be used.
 	Logger logging.Logger
+
+	// AppInfo is the information about the "app" which this integration belongs to. If nil, the app info set via
+	// SetAppInfo will be used.
+	AppInfo *AppInfo
+
+	// Key is the API key used for requests that don't carry their own key. It's a shorthand for setting Credentials to
+	// StaticCredentials(Key) and is ignored if Credentials is set.
+	Key string
+
+	// Credentials provides the API key for requests. It's consulted for every request and takes precedence over the
+	// key a request carries. If both Credentials and Key are empty, requests without a key are sent unauthenticated.
+	Credentials CredentialProvider
+
+	// CheckTokenScope enables checking requests for being bound to fail because the type of the used token has no
+	// access to the requested endpoint, e.g. a service token used to create a project. Such requests fail with a
+	// TokenScopeError without being sent. The check is disabled by default.
+	CheckTokenScope bool
 }
 
 // Backend is the backend used by the SDK. It is used to make requests to the API.
@@ -67,9 +91,17 @@ type Backend interface {
 
 // backendImplementation is the default backend implementation. It satisfies the Backend interface.
 type backendImplementation struct {
-	URL        string
-	HTTPClient *http.Client
-	Logger     logging.Logger
+	URL         string
+	HTTPClient  *http.Client
+	Logger      logging.Logger
+	Credentials CredentialProvider
+	UserAgent   string
+
+	// CredentialsFirst is set if Credentials was configured explicitly rather than derived from BackendConfig.Key,
+	// in which case it takes precedence over request keys.
+	CredentialsFirst bool
+
+	CheckTokenScope bool
 }
 
 // Compile-time check to ensure that backendImplementation implements the Backend interface.
@@ -87,7 +119,8 @@ type Request struct {
 	// Path is the path to the API endpoint. e.g. "/projects"
 	Path string `json:"path"`
 
-	// Key is the API key to use.
+	// Key is the API key to use. It's ignored if the backend was configured with a CredentialProvider, which is
+	// consulted instead.
 	Key string `json:"-"`
 
 	// Payload is expected to be a struct holding all necessary query and body parameters. Every field in the struct
@@ -256,10 +289,28 @@ func newBackendImplementation(config *BackendConfig) Backend {
 		config.Logger = &logging.NopLogger{}
 	}
 
+	// Credentials
+	credentialsFirst := config.Credentials != nil
+	if config.Credentials == nil && config.Key != "" {
+		config.Credentials = StaticCredentials(config.Key)
+	}
+
+	// User agent; an empty user agent means that the global one is used at request time.
+	var userAgent string
+	if config.AppInfo != nil {
+		userAgent = formatSDKUserAgent(config.AppInfo)
+	}
+
 	return &backendImplementation{
-		HTTPClient: config.Client,
-		URL:        *config.URL,
-		Logger:     config.Logger,
+		HTTPClient:  config.Client,
+		URL:         *config.URL,
+		Logger:      config.Logger,
+		Credentials: config.Credentials,
+		UserAgent:   userAgent,
+
+		CredentialsFirst: credentialsFirst,
+
+		CheckTokenScope: config.CheckTokenScope,
 	}
 }
 
@@ -271,7 +322,7 @@ func GetBackendWithConfig(config *BackendConfig) Backend {
 
 // GetBackend returns a new backend with the default configuration.
 func GetBackend() Backend {
-	return GetBackendWithConfig(&BackendConfig{Client: defaultClient})
+	return GetBackendWithConfig(&BackendConfig{Client: defaultClient, Credentials: Credentials})
 }
 
 func (req *Request) getQueryParameters() (parameters, error) {
@@ -295,14 +346,32 @@ func (req *Request) getBody() (*bytes.Buffer, error) {
 // prepareRequest creates a new HTTP request from the given Request. The returned request is ready to be sent to the
 // API.
 func (b *backendImplementation) prepareRequest(ctx context.Context, req *Request) (*http.Request, error) {
-	// Normalize URL
-	if !strings.HasPrefix(req.Path, "/") {
-		req.Path = "/" + req.Path
+	// Validate the payload first; there's no point in resolving keys for a request that is bound to fail.
+	if err := ValidateOptions(req.Payload); err != nil {
+		return nil, err
+	}
+
+	// Normalize URL; the request itself is left untouched, so that it can be prepared again, e.g. for a retry.
+	path := req.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	// Resolve the API key
+	key, err := b.resolveKey(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolve api key")
+	}
+
+	// Fail fast if the key can't be used for the requested endpoint anyway
+	if b.CheckTokenScope {
+		if err := checkTokenScope(TokenTypeOf(key), req.Method, path); err != nil {
+			return nil, err
+		}
 	}
-	req.Path = b.URL + req.Path
 
 	// Create basic HTTP request
-	httpReq, err := http.NewRequestWithContext(ctx, req.Method, req.Path, nil)
+	httpReq, err := http.NewRequestWithContext(ctx, req.Method, b.URL+path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -328,9 +397,9 @@ func (b *backendImplementation) prepareRequest(ctx context.Context, req *Request
 	}
 
 	// Set headers
-	httpReq.SetBasicAuth(req.Key, "")
+	httpReq.SetBasicAuth(key, "")
 	httpReq.Header.Add("Accept", "application/json")
-	httpReq.Header.Add("User-Agent", encodedUserAgent)
+	httpReq.Header.Add("User-Agent", b.userAgent())
 
 	// Set custom headers; doing this last so that we can override the default headers
 	for key, values := range req.Header {
@@ -342,16 +411,72 @@ func (b *backendImplementation) prepareRequest(ctx context.Context, req *Request
 	return httpReq, nil
 }
 
-func (b *backendImplementation) call(ctx context.Context, req *Request) (*http.Response, error) {
+// userAgent returns the User-Agent header value for the backend. If the backend was configured with its own app info,
+// it takes precedence over the one set via SetAppInfo.
+func (b *backendImplementation) userAgent() string {
+	if b.UserAgent == "" {
+		return getEncodedUserAgent()
+	}
+
+	return b.UserAgent
+}
+
+// usesCredentials reports whether the API key for the given request is provided by the backend's CredentialProvider.
+// An explicitly configured provider takes precedence over the request's key; the one derived from BackendConfig.Key
+// is only a fallback for requests without a key.
+func (b *backendImplementation) usesCredentials(req *Request) bool {
+	return b.Credentials != nil && (b.CredentialsFirst || req.Key == "")
+}
+
+// resolveKey returns the API key for the given request.
+func (b *backendImplementation) resolveKey(ctx context.Context, req *Request) (string, error) {
+	if !b.usesCredentials(req) {
+		return req.Key, nil
+	}
+
+	return b.Credentials.Key(ctx)
+}
+
+func (b *backendImplementation) send(ctx context.Context, req *Request) (*http.Request, *http.Response, error) {
 	// Translate our internal request to an HTTP request
 	httpReq, err := b.prepareRequest(ctx, req)
 	if err != nil {
-		return nil, errors.Wrap(err, "prepare request")
+		return nil, nil, errors.Wrap(err, "prepare request")
 	}
 
 	b.Logger.Infow("Sending HTTP request", "method", httpReq.Method, "url", httpReq.URL.String())
 
-	return b.HTTPClient.Do(httpReq)
+	httpResp, err := b.HTTPClient.Do(httpReq)
+
+	return httpReq, httpResp, err
+}
+
+func (b *backendImplementation) call(ctx context.Context, req *Request) (*http.Response, error) {
+	httpReq, httpResp, err := b.send(ctx, req)
+	if err != nil || httpResp.StatusCode != http.StatusUnauthorized || !b.usesCredentials(req) {
+		return httpResp, err
+	}
+
+	// The key got rejected. It may have been rotated since we resolved it, so we give the CredentialProvider a chance
+	// to come up with a new one. We only retry once, and only if the key actually changed.
+	usedKey, _, _ := httpReq.BasicAuth()
+	if invalidator, ok := b.Credentials.(CredentialInvalidator); ok {
+		invalidator.Invalidate(usedKey)
+	}
+
+	newKey, err := b.Credentials.Key(ctx)
+	if err != nil || newKey == usedKey {
+		return httpResp, nil
+	}
+
+	b.Logger.Infow("API key got rejected, retrying with refreshed key", "method", httpReq.Method, "url", httpReq.URL.String())
+
+	_, _ = io.Copy(io.Discard, httpResp.Body)
+	_ = httpResp.Body.Close()
+
+	_, httpResp, err = b.send(ctx, req)
+
+	return httpResp, err
 }
 
 // CallRaw sends the given request to the API and returns the raw HTTP response. This is useful if you want to handle
@@ -430,33 +555,45 @@ func (a *AppInfo) formatUserAgent() string {
 	return userAgent
 }
 
+// formatSDKUserAgent returns the full User-Agent string of the SDK for the given AppInfo, which may be nil.
+func formatSDKUserAgent(info *AppInfo) string {
+	userAgent := "doppler-go/" + SDKVersion
+	if info != nil {
+		userAgent += " " + info.formatUserAgent()
+	}
+
+	return userAgent
+}
+
 var (
-	appInfo          *AppInfo
-	encodedUserAgent string
+	// appInfoMu guards encodedUserAgent. It's only ever used as default for backends that weren't configured with
+	// their own app info.
+	appInfoMu        sync.RWMutex
+	encodedUserAgent = formatSDKUserAgent(nil)
 )
 
-// SetAppInfo sets the information about the "app" which this integration belongs to.
+// SetAppInfo sets the information about the "app" which this integration belongs to. It's used by all backends which
+// weren't configured with their own app info via BackendConfig.AppInfo. It's safe for concurrent use.
 func SetAppInfo(info *AppInfo) {
 	if info != nil && info.Name == "" {
-		panic("info.NewConfig must not be empty")
+		panic("info.Name must not be empty")
 	}
 
-	appInfo = info
+	// Format the user agent before taking the lock, so that later changes to info by the caller don't matter.
+	userAgent := formatSDKUserAgent(info)
 
-	// We need to re-init since we have a new app info.
-	initUserAgent()
-}
+	appInfoMu.Lock()
+	defer appInfoMu.Unlock()
 
-// initUserAgent initializes the encodedDopplerUserAgent and encodedUserAgent variables.
-func initUserAgent() {
-	encodedUserAgent = "doppler-go/" + SDKVersion
-	if appInfo != nil {
-		encodedUserAgent += " " + appInfo.formatUserAgent()
-	}
+	encodedUserAgent = userAgent
 }
 
-func init() {
-	initUserAgent()
+// getEncodedUserAgent returns the global User-Agent string. It's safe for concurrent use.
+func getEncodedUserAgent() string {
+	appInfoMu.RLock()
+	defer appInfoMu.RUnlock()
+
+	return encodedUserAgent
 }
 
 // getUname returns a string containing the uname information. This is used to add additional debugging information. It
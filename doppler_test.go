@@ -8,6 +8,7 @@ import (
 	"net/textproto"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -177,6 +178,86 @@ func TestGetBackend(t *testing.T) {
 	}
 }
 
+func TestBackendImplementation_userAgent(t *testing.T) {
+	t.Parallel()
+
+	// A backend without app info falls back to the global user agent.
+	backend := &backendImplementation{}
+	if got := backend.userAgent(); got != getEncodedUserAgent() {
+		t.Errorf("userAgent() = %q, want %q", got, getEncodedUserAgent())
+	}
+
+	// A backend with app info uses its own.
+	backend = newBackendImplementation(&BackendConfig{AppInfo: &AppInfo{Name: "doppler", Version: "0.1.0"}}).(*backendImplementation)
+	want := "doppler-go/" + SDKVersion + " doppler/0.1.0"
+	if got := backend.userAgent(); got != want {
+		t.Errorf("userAgent() = %q, want %q", got, want)
+	}
+}
+
+//nolint:paralleltest // This test is not parallel because it accesses global state.
+func TestSetAppInfo_Concurrent(t *testing.T) {
+	// Create a fake HTTP server that just acknowledges every request.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	backend := GetBackendWithConfig(&BackendConfig{URL: pointer.To(server.URL)})
+
+	// Concurrently change the global app info while sending requests. Run with -race to detect data races.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			SetAppInfo(&AppInfo{Name: "doppler", Version: strconv.Itoa(i)})
+		}(i)
+		go func() {
+			defer wg.Done()
+			_ = backend.Call(context.Background(), &Request{Method: http.MethodGet, Path: "/v3/me"}, nil)
+		}()
+	}
+	wg.Wait()
+
+	SetAppInfo(nil)
+	if got, want := getEncodedUserAgent(), "doppler-go/"+SDKVersion; got != want {
+		t.Errorf("getEncodedUserAgent() = %q, want %q", got, want)
+	}
+}
+
+func TestBackendImplementation_Key(t *testing.T) {
+	t.Parallel()
+
+	// Create a fake HTTP server that echoes the key used for authentication.
+	keys := make(chan string, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key, _, _ := r.BasicAuth()
+		keys <- key
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	backend := GetBackendWithConfig(&BackendConfig{URL: pointer.To(server.URL), Key: "backend-key"})
+
+	// Requests without a key fall back to the backend's key.
+	if err := backend.Call(context.Background(), &Request{Method: http.MethodGet, Path: "/v3/me"}, nil); err != nil {
+		t.Fatalf("Call() returned an error: %v", err)
+	}
+	if got := <-keys; got != "backend-key" {
+		t.Errorf("Expected key %q, got %q", "backend-key", got)
+	}
+
+	// Requests with a key take precedence.
+	err := backend.Call(context.Background(), &Request{Method: http.MethodGet, Path: "/v3/me", Key: "request-key"}, nil)
+	if err != nil {
+		t.Fatalf("Call() returned an error: %v", err)
+	}
+	if got := <-keys; got != "request-key" {
+		t.Errorf("Expected key %q, got %q", "request-key", got)
+	}
+}
+
 func TestNopReadCloser_Close(t *testing.T) {
 	t.Parallel()
 
@@ -1,14 +1,33 @@
 package doppler
 
+// ConfigCreationPolicy defines who may create branch configs in an environment.
+type ConfigCreationPolicy string
+
+const (
+	// ConfigCreationPolicyEveryone allows every project member with access to the environment to create branch configs.
+	ConfigCreationPolicyEveryone ConfigCreationPolicy = "everyone"
+
+	// ConfigCreationPolicyAdmins allows only project admins to create branch configs.
+	ConfigCreationPolicyAdmins ConfigCreationPolicy = "admins"
+)
+
 type (
 	// Environment represents a doppler environment.
 	Environment struct {
-		ID             *string `json:"id,omitempty"`               // An identifier for the object.
-		Slug           *string `json:"slug,omitempty"`             // A unique identifier for the environment.
-		Name           *string `json:"name,omitempty"`             // Name of the environment.
-		Project        *string `json:"project,omitempty"`          // Identifier of the project the environment belongs to.
-		InitialFetchAt *string `json:"initial_fetch_at,omitempty"` // Date and time of the first secrets fetch from a config in the environment.
-		CreatedAt      *string `json:"created_at,omitempty"`       // Date and time of the object's creation.
+		ID                   *string               `json:"id,omitempty"`                     // An identifier for the object.
+		Slug                 *string               `json:"slug,omitempty"`                   // A unique identifier for the environment.
+		Name                 *string               `json:"name,omitempty"`                   // Name of the environment.
+		Project              *string               `json:"project,omitempty"`                // Identifier of the project the environment belongs to.
+		PersonalConfigs      *bool                 `json:"personal_configs,omitempty"`       // Whether members can create personal configs in the environment.
+		ConfigCreationPolicy *ConfigCreationPolicy `json:"config_creation_policy,omitempty"` // Who may create branch configs in the environment.
+		InitialFetchAt       *string               `json:"initial_fetch_at,omitempty"`       // Date and time of the first secrets fetch from a config in the environment.
+		CreatedAt            *string               `json:"created_at,omitempty"`             // Date and time of the object's creation.
+	}
+
+	// ConfigTree represents the configs of an environment: its root config and the configs branched off of it.
+	ConfigTree struct {
+		Root     *Config   `json:"root,omitempty"`     // The root config of the environment.
+		Branches []*Config `json:"branches,omitempty"` // The branch configs of the environment, sorted by name.
 	}
 
 	// EnvironmentGetResponse represents a response from the environment get endpoint.
@@ -23,8 +42,8 @@ type (
 
 	// EnvironmentGetOptions represents the options for the environment get endpoint.
 	EnvironmentGetOptions struct {
-		Project string `url:"project" json:"-"`     // Identifier of the project the environment belongs to.
-		Slug    string `url:"environment" json:"-"` // A unique identifier for the environment.
+		Project string `url:"project" json:"-" validate:"required"`     // Identifier of the project the environment belongs to.
+		Slug    string `url:"environment" json:"-" validate:"required"` // A unique identifier for the environment.
 	}
 
 	// EnvironmentListResponse represents a response from the environment list endpoint.
@@ -39,7 +58,7 @@ type (
 
 	// EnvironmentListOptions represents the query parameters for a environment list request.
 	EnvironmentListOptions struct {
-		Project string `url:"project" json:"-"` // Identifier of the project the environment belongs to.
+		Project string `url:"project" json:"-" validate:"required"` // Identifier of the project the environment belongs to.
 	}
 
 	// EnvironmentCreateResponse represents a response from the environment create endpoint.
@@ -54,9 +73,11 @@ type (
 
 	// EnvironmentCreateOptions represents the body parameters for a environment create request.
 	EnvironmentCreateOptions struct {
-		Project string `url:"project" json:"-"`        // Identifier of the project the environment belongs to.
-		Name    string `url:"-" json:"name,omitempty"` // Name of the environment.
-		Slug    string `url:"-" json:"slug,omitempty"` // A unique identifier for the environment.
+		Project              string                `url:"project" json:"-" validate:"required"`                                                 // Identifier of the project the environment belongs to.
+		Name                 string                `url:"-" json:"name,omitempty" validate:"required"`                                          // Name of the environment.
+		Slug                 string                `url:"-" json:"slug,omitempty" validate:"required"`                                          // A unique identifier for the environment.
+		PersonalConfigs      *bool                 `url:"-" json:"personal_configs,omitempty"`                                                  // Whether members can create personal configs in the environment.
+		ConfigCreationPolicy *ConfigCreationPolicy `url:"-" json:"config_creation_policy,omitempty" validate:"omitempty,oneof=everyone admins"` // Who may create branch configs in the environment.
 	}
 
 	// EnvironmentRenameResponse represents a doppler environment rename request.
@@ -71,10 +92,10 @@ type (
 
 	// EnvironmentRenameOptions represents the body parameters for a environment rename request.
 	EnvironmentRenameOptions struct {
-		Project string  `url:"project" json:"-"`        // Identifier of the project the environment belongs to.
-		Slug    string  `url:"environment" json:"-"`    // A unique identifier for the environment.
-		NewName *string `url:"-" json:"name,omitempty"` // New name of the environment.
-		NewSlug *string `url:"-" json:"slug,omitempty"` // New slug of the environment.
+		Project string  `url:"project" json:"-" validate:"required"`                        // Identifier of the project the environment belongs to.
+		Slug    string  `url:"environment" json:"-" validate:"required"`                    // A unique identifier for the environment.
+		NewName *string `url:"-" json:"name,omitempty" validate:"required_without=NewSlug"` // New name of the environment.
+		NewSlug *string `url:"-" json:"slug,omitempty" validate:"required_without=NewName"` // New slug of the environment.
 	}
 
 	// EnvironmentDeleteResponse represents a response from the environment delete endpoint.
@@ -88,7 +109,24 @@ type (
 
 	// EnvironmentDeleteOptions represents the body parameters for a environment delete request.
 	EnvironmentDeleteOptions struct {
-		Project string `url:"project" json:"-"`     // Identifier of the project the environment belongs to.
-		Slug    string `url:"environment" json:"-"` // A unique identifier for the environment.
+		Project string `url:"project" json:"-" validate:"required"`     // Identifier of the project the environment belongs to.
+		Slug    string `url:"environment" json:"-" validate:"required"` // A unique identifier for the environment.
+	}
+
+	// EnvironmentSettingsUpdateResponse represents a response from the environment settings update endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/environments/environment/settings
+	EnvironmentSettingsUpdateResponse struct {
+		APIResponse `json:",inline"`
+		Environment *Environment `json:"environment"`
+	}
+
+	// EnvironmentSettingsUpdateOptions represents the parameters for a environment settings update request.
+	EnvironmentSettingsUpdateOptions struct {
+		Project                 string                `url:"project" json:"-" validate:"required"`                                                                                     // Identifier of the project the environment belongs to.
+		Slug                    string                `url:"environment" json:"-" validate:"required"`                                                                                 // A unique identifier for the environment.
+		NewPersonalConfigs      *bool                 `url:"-" json:"personal_configs,omitempty" validate:"required_without=NewConfigCreationPolicy"`                                  // Whether members can create personal configs in the environment.
+		NewConfigCreationPolicy *ConfigCreationPolicy `url:"-" json:"config_creation_policy,omitempty" validate:"required_without=NewPersonalConfigs,omitempty,oneof=everyone admins"` // Who may create branch configs in the environment.
 	}
 )
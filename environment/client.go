@@ -3,10 +3,17 @@ package environment
 import (
 	"context"
 	"net/http"
+	"sort"
+
+	"github.com/pkg/errors"
 
 	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/config"
 )
 
+// ErrRootConfigNotFound is returned by Configs if the environment has no root config.
+var ErrRootConfigNotFound = errors.New("root config not found")
+
 // Client is the client used to invoke /v3/environments APIs.
 type Client struct {
 	Backend doppler.Backend
@@ -131,3 +138,84 @@ func (c Client) Delete(ctx context.Context, opts *doppler.EnvironmentDeleteOptio
 func Delete(ctx context.Context, opts *doppler.EnvironmentDeleteOptions) (doppler.APIResponse, error) {
 	return Default().Delete(ctx, opts)
 }
+
+func (c Client) updateSettings(ctx context.Context, opts *doppler.EnvironmentSettingsUpdateOptions) (*doppler.Environment, doppler.APIResponse, error) {
+	var resp doppler.EnvironmentSettingsUpdateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/environments/environment/settings",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Environment, resp.APIResponse, err
+}
+
+// UpdateSettings changes the personal configs and config creation settings of an environment.
+func (c Client) UpdateSettings(ctx context.Context, opts *doppler.EnvironmentSettingsUpdateOptions) (*doppler.Environment, doppler.APIResponse, error) {
+	return c.updateSettings(ctx, opts)
+}
+
+// UpdateSettings changes the personal configs and config creation settings of an environment using the default client.
+func UpdateSettings(ctx context.Context, opts *doppler.EnvironmentSettingsUpdateOptions) (*doppler.Environment, doppler.APIResponse, error) {
+	return Default().UpdateSettings(ctx, opts)
+}
+
+// configName returns the name of the given config, or an empty string if it has none.
+func configName(config *doppler.Config) string {
+	if config.Name == nil {
+		return ""
+	}
+
+	return *config.Name
+}
+
+func (c Client) configs(ctx context.Context, project, environment string) (*doppler.ConfigTree, error) {
+	configs := &config.Client{Backend: c.Backend, Key: c.Key}
+	it := doppler.NewPageIterator(ctx, doppler.ListOptions{}, func(ctx context.Context, page doppler.ListOptions) ([]*doppler.Config, error) {
+		list, _, err := configs.List(ctx, &doppler.ConfigListOptions{ListOptions: page, Project: project, Environment: &environment})
+		return list, err
+	})
+
+	tree := &doppler.ConfigTree{}
+	for {
+		cfg, err := it.Next()
+		if errors.Is(err, doppler.ErrIteratorDone) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "list configs of environment %q", environment)
+		}
+
+		// Guard against the filter being ignored, e.g. by older API versions.
+		if cfg.Environment != nil && *cfg.Environment != environment {
+			continue
+		}
+		if cfg.Root != nil && *cfg.Root {
+			tree.Root = cfg
+		} else {
+			tree.Branches = append(tree.Branches, cfg)
+		}
+	}
+
+	if tree.Root == nil {
+		return nil, errors.Wrapf(ErrRootConfigNotFound, "environment %q", environment)
+	}
+
+	sort.Slice(tree.Branches, func(i, j int) bool {
+		return configName(tree.Branches[i]) < configName(tree.Branches[j])
+	})
+
+	return tree, nil
+}
+
+// Configs returns the root config of an environment and the configs branched off of it. It returns
+// ErrRootConfigNotFound if the environment has no root config.
+func (c Client) Configs(ctx context.Context, project, environment string) (*doppler.ConfigTree, error) {
+	return c.configs(ctx, project, environment)
+}
+
+// Configs returns the root config of an environment and the configs branched off of it using the default client.
+func Configs(ctx context.Context, project, environment string) (*doppler.ConfigTree, error) {
+	return Default().Configs(ctx, project, environment)
+}
@@ -3,8 +3,11 @@ package environment_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -292,6 +295,43 @@ func TestEnvironment_Create(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Create environment with settings",
+			options: &doppler.EnvironmentCreateOptions{
+				Project:              "p1",
+				Name:                 "Environment-1",
+				Slug:                 "e1",
+				PersonalConfigs:      pointer.To(true),
+				ConfigCreationPolicy: pointer.To(doppler.ConfigCreationPolicyAdmins),
+			},
+			wantEnvironment: &doppler.Environment{
+				ID:                   pointer.To("Environtment-1"),
+				Slug:                 pointer.To("e1"),
+				Name:                 pointer.To("Environment-1"),
+				Project:              pointer.To("p1"),
+				PersonalConfigs:      pointer.To(true),
+				ConfigCreationPolicy: pointer.To(doppler.ConfigCreationPolicyAdmins),
+				CreatedAt:            pointer.To("2021-01-01T00:00:00.000Z"),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "201 Created",
+				StatusCode: http.StatusCreated,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create environment with invalid config creation policy",
+			options: &doppler.EnvironmentCreateOptions{
+				Project:              "p1",
+				Name:                 "Environment-1",
+				Slug:                 "e1",
+				ConfigCreationPolicy: pointer.To(doppler.ConfigCreationPolicy("nobody")),
+			},
+			wantEnvironment: nil,
+			wantResponse:    doppler.APIResponse{},
+			wantErr:         true,
+		},
 		{
 			name: "Create environment with unknown project",
 			options: &doppler.EnvironmentCreateOptions{
@@ -409,13 +449,8 @@ func TestEnvironment_Rename(t *testing.T) {
 				Slug:    "e1",
 			},
 			wantEnvironment: nil,
-			wantResponse: doppler.APIResponse{
-				Success:    pointer.To(false),
-				Status:     "400 Bad Request",
-				StatusCode: http.StatusBadRequest,
-				Messages:   []string{"name or slug is required"},
-			},
-			wantErr: true,
+			wantResponse:    doppler.APIResponse{}, // Name or slug is required; rejected by the options validation.
+			wantErr:         true,
 		},
 		{
 			name:            "Rename environment with invalid options error",
@@ -565,3 +600,227 @@ func TestEnvironment_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironment_UpdateSettings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		options         *doppler.EnvironmentSettingsUpdateOptions
+		wantBody        map[string]any
+		wantEnvironment *doppler.Environment
+		wantResponse    doppler.APIResponse
+		wantErr         bool
+	}{
+		{
+			name: "Update environment settings",
+			options: &doppler.EnvironmentSettingsUpdateOptions{
+				Project:                 "backend",
+				Slug:                    "prd",
+				NewPersonalConfigs:      pointer.To(false),
+				NewConfigCreationPolicy: pointer.To(doppler.ConfigCreationPolicyAdmins),
+			},
+			wantBody: map[string]any{"personal_configs": false, "config_creation_policy": "admins"},
+			wantEnvironment: &doppler.Environment{
+				Slug:                 pointer.To("prd"),
+				Name:                 pointer.To("Production"),
+				Project:              pointer.To("backend"),
+				PersonalConfigs:      pointer.To(false),
+				ConfigCreationPolicy: pointer.To(doppler.ConfigCreationPolicyAdmins),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:            "Update environment settings with error",
+			options:         &doppler.EnvironmentSettingsUpdateOptions{Project: "backend", Slug: "prd", NewPersonalConfigs: pointer.To(true)},
+			wantBody:        map[string]any{"personal_configs": true},
+			wantEnvironment: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Environment not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:            "Update environment settings without changes",
+			options:         &doppler.EnvironmentSettingsUpdateOptions{Project: "backend", Slug: "prd"},
+			wantEnvironment: nil,
+			wantResponse:    doppler.APIResponse{},
+			wantErr:         true,
+		},
+		{
+			name:            "Update environment settings with invalid policy",
+			options:         &doppler.EnvironmentSettingsUpdateOptions{Project: "backend", Slug: "prd", NewConfigCreationPolicy: pointer.To(doppler.ConfigCreationPolicy("nobody"))},
+			wantEnvironment: nil,
+			wantResponse:    doppler.APIResponse{},
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/environments/environment/settings" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if q := r.URL.Query(); tt.options != nil && (q.Get("project") != tt.options.Project || q.Get("environment") != tt.options.Slug) {
+					t.Errorf("Unexpected query parameters: %s", r.URL.RawQuery)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.EnvironmentSettingsUpdateResponse{
+					Environment: tt.wantEnvironment,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &environment.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotEnvironment, gotResponse, err := client.UpdateSettings(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantEnvironment, gotEnvironment); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEnvironment_Configs(t *testing.T) {
+	t.Parallel()
+
+	// Generate enough branch configs to span multiple pages.
+	configs := []*doppler.Config{
+		{Name: pointer.To("prd"), Environment: pointer.To("prd"), Root: pointer.To(true)},
+		{Name: pointer.To("stg"), Environment: pointer.To("stg"), Root: pointer.To(true)},
+	}
+	var wantBranches []*doppler.Config
+	for i := 0; i < 120; i++ {
+		config := &doppler.Config{Name: pointer.To(fmt.Sprintf("prd_branch_%03d", i)), Environment: pointer.To("prd"), Root: pointer.To(false)}
+		wantBranches = append(wantBranches, config)
+	}
+	// Add the branches in reverse order, to check that they're sorted.
+	for i := len(wantBranches) - 1; i >= 0; i-- {
+		configs = append(configs, wantBranches[i])
+	}
+
+	tests := []struct {
+		name        string
+		configs     []*doppler.Config
+		environment string
+		wantTree    *doppler.ConfigTree
+		wantErr     error
+	}{
+		{
+			name:        "Config tree of environment",
+			configs:     configs,
+			environment: "prd",
+			wantTree:    &doppler.ConfigTree{Root: configs[0], Branches: wantBranches},
+		},
+		{
+			name:        "Config tree of environment without branches",
+			configs:     configs,
+			environment: "stg",
+			wantTree:    &doppler.ConfigTree{Root: configs[1]},
+		},
+		{
+			name:        "Config tree of environment without root config",
+			configs:     configs[2:],
+			environment: "prd",
+			wantErr:     environment.ErrRootConfigNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Mock the config list endpoint; the environment filter is ignored to check the client-side guard.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/configs" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				q := r.URL.Query()
+				if q.Get("project") != "backend" || q.Get("environment") != tt.environment {
+					t.Errorf("Unexpected query parameters: %s", r.URL.RawQuery)
+				}
+
+				page, _ := strconv.Atoi(q.Get("page"))
+				perPage, _ := strconv.Atoi(q.Get("per_page"))
+				start, end := (page-1)*perPage, page*perPage
+				if start > len(tt.configs) {
+					start = len(tt.configs)
+				}
+				if end > len(tt.configs) {
+					end = len(tt.configs)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				err := json.NewEncoder(w).Encode(&doppler.ConfigListResponse{
+					APIResponse: doppler.APIResponse{Success: pointer.To(true)},
+					Configs:     tt.configs[start:end],
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			client := &environment.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotTree, err := client.Configs(context.Background(), "backend", tt.environment)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Unexpected error. Expected %v, got %v", tt.wantErr, err)
+			}
+			if diff := cmp.Diff(tt.wantTree, gotTree); diff != "" {
+				t.Errorf("Unexpected config tree (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
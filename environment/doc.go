@@ -17,5 +17,16 @@ Example:
 	for _, env := range environments {
 		fmt.Println(env.ID)
 	}
+
+	// Print the root config of the production environment and its branches
+	tree, err := environment.Configs(ctx, "my-project", "prd")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(*tree.Root.Name)
+	for _, branch := range tree.Branches {
+		fmt.Println("  " + *branch.Name)
+	}
 */
 package environment
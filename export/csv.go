@@ -0,0 +1,132 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Column is a column of a CSV export, named after the record's JSON field.
+type Column string
+
+const (
+	ColumnKind        Column = "kind"        // Kind of the log.
+	ColumnID          Column = "id"          // Unique identifier of the log.
+	ColumnCreatedAt   Column = "created_at"  // Date and time of the log's creation.
+	ColumnText        Column = "text"        // Text describing the event.
+	ColumnUserName    Column = "user_name"   // Name of the user.
+	ColumnUserEmail   Column = "user_email"  // Email address of the user.
+	ColumnProject     Column = "project"     // Identifier of the project.
+	ColumnEnvironment Column = "environment" // Identifier of the environment.
+	ColumnConfig      Column = "config"      // Name of the config.
+	ColumnRollback    Column = "rollback"    // Whether the config log is a rollback.
+	ColumnSecrets     Column = "secrets"     // Names of the changed secrets, separated by semicolons.
+)
+
+// DefaultColumns are the columns of a CSV export if none are given.
+var DefaultColumns = []Column{
+	ColumnKind, ColumnID, ColumnCreatedAt, ColumnUserEmail, ColumnProject, ColumnEnvironment, ColumnConfig, ColumnText,
+}
+
+// knownColumns is the set of all columns.
+var knownColumns = map[Column]bool{
+	ColumnKind: true, ColumnID: true, ColumnCreatedAt: true, ColumnText: true, ColumnUserName: true,
+	ColumnUserEmail: true, ColumnProject: true, ColumnEnvironment: true, ColumnConfig: true, ColumnRollback: true,
+	ColumnSecrets: true,
+}
+
+// ErrUnknownColumn is returned when a CSV encoder is created with an unknown column.
+var ErrUnknownColumn = errors.New("unknown column")
+
+// field returns the value of the given column of the record.
+func (r *Record) field(c Column) string {
+	switch c {
+	case ColumnKind:
+		return string(r.Kind)
+	case ColumnID:
+		return r.ID
+	case ColumnCreatedAt:
+		return r.CreatedAt
+	case ColumnText:
+		return r.Text
+	case ColumnUserName:
+		return r.UserName
+	case ColumnUserEmail:
+		return r.UserEmail
+	case ColumnProject:
+		return r.Project
+	case ColumnEnvironment:
+		return r.Environment
+	case ColumnConfig:
+		return r.Config
+	case ColumnRollback:
+		return strconv.FormatBool(r.Rollback)
+	case ColumnSecrets:
+		return strings.Join(r.Secrets, ";")
+	default:
+		return ""
+	}
+}
+
+// CSVEncoder writes records as CSV with a header row.
+type CSVEncoder struct {
+	w       *csv.Writer
+	columns []Column
+	header  bool // Whether the header row was written.
+}
+
+// NewCSVEncoder returns an encoder writing CSV with the given columns to w. Without columns, DefaultColumns are used.
+func NewCSVEncoder(w io.Writer, columns ...Column) (*CSVEncoder, error) {
+	if len(columns) == 0 {
+		columns = DefaultColumns
+	}
+	for _, c := range columns {
+		if !knownColumns[c] {
+			return nil, errors.Wrapf(ErrUnknownColumn, "%q", c)
+		}
+	}
+
+	return &CSVEncoder{w: csv.NewWriter(w), columns: columns}, nil
+}
+
+// writeHeader writes the header row, unless it was written already.
+func (e *CSVEncoder) writeHeader() error {
+	if e.header {
+		return nil
+	}
+	e.header = true
+
+	row := make([]string, len(e.columns))
+	for i, c := range e.columns {
+		row[i] = string(c)
+	}
+
+	return e.w.Write(row)
+}
+
+// Encode writes the record as a single row, preceded by the header row for the first record.
+func (e *CSVEncoder) Encode(r *Record) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+
+	row := make([]string, len(e.columns))
+	for i, c := range e.columns {
+		row[i] = r.field(c)
+	}
+
+	return e.w.Write(row)
+}
+
+// Flush writes the buffered rows. The header row is written even if no record was encoded.
+func (e *CSVEncoder) Flush() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	e.w.Flush()
+
+	return e.w.Error()
+}
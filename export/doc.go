@@ -0,0 +1,34 @@
+/*
+Package export writes Doppler activity and config logs to other systems, e.g. a SIEM. Logs are read from an iterator
+and written as JSON Lines, CSV or RFC 5424 syslog messages, optionally carrying CEF events, to an io.Writer or a
+syslog endpoint.
+
+Example:
+
+	// Send the activity logs to a syslog endpoint as CEF events, hashing user emails
+	enc, err := export.DialSyslog("tcp", "siem.example.com:514", &export.SyslogOptions{Format: export.SyslogCEF})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer enc.Close()
+
+	n, err := export.ActivityLogs(enc, activitylog.Iter(ctx, nil), &export.Options{
+		HashEmails: true,
+		HashKey:    []byte(os.Getenv("EXPORT_HASH_KEY")),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("exported %d activity logs\n", n)
+
+	// Write the config logs of a config as CSV
+	csvEnc, err := export.NewCSVEncoder(os.Stdout, export.ColumnCreatedAt, export.ColumnUserEmail, export.ColumnSecrets)
+	if err != nil {
+		log.Fatal(err)
+	}
+	_, err = export.ConfigLogs(csvEnc, configlog.Iter(ctx, &doppler.ConfigLogListOptions{
+		Project: "my-project",
+		Config:  "prd",
+	}), nil)
+*/
+package export
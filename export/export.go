@@ -0,0 +1,192 @@
+package export
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/nikoksr/doppler-go"
+)
+
+// Kind is the kind of log a record was created from.
+type Kind string
+
+const (
+	// KindActivity is the kind of records created from activity logs.
+	KindActivity Kind = "activity"
+
+	// KindConfig is the kind of records created from config logs.
+	KindConfig Kind = "config"
+)
+
+// Record is the flattened, exportable form of an activity or config log. HTML fields aren't exported, and secret values
+// of config logs never leave the SDK; only the names of the changed secrets are exported.
+type Record struct {
+	Kind        Kind     `json:"kind"`                  // Kind of the log.
+	ID          string   `json:"id"`                    // Unique identifier of the log.
+	CreatedAt   string   `json:"created_at,omitempty"`  // Date and time of the log's creation.
+	Text        string   `json:"text,omitempty"`        // Text describing the event.
+	UserName    string   `json:"user_name,omitempty"`   // Name of the user that triggered the event.
+	UserEmail   string   `json:"user_email,omitempty"`  // Email address of the user, hashed if requested.
+	Project     string   `json:"project,omitempty"`     // Identifier of the project.
+	Environment string   `json:"environment,omitempty"` // Identifier of the environment.
+	Config      string   `json:"config,omitempty"`      // Name of the config.
+	Rollback    bool     `json:"rollback,omitempty"`    // Whether the config log is a rollback.
+	Secrets     []string `json:"secrets,omitempty"`     // Sorted names of the secrets changed by the config log.
+}
+
+// Encoder writes records in a specific format.
+type Encoder interface {
+	// Encode writes a single record.
+	Encode(r *Record) error
+
+	// Flush writes any buffered data.
+	Flush() error
+}
+
+// ErrMissingHashKey is returned if emails should be hashed without a hash key.
+var ErrMissingHashKey = errors.New("hashing emails requires a hash key")
+
+// Options represents the options for exporting logs.
+type Options struct {
+	// HashEmails replaces user emails with their hex-encoded HMAC-SHA-256 hash, keyed with HashKey. Emails are
+	// lowercased first, so that all spellings of an email have the same hash.
+	HashEmails bool
+
+	// HashKey is the secret key emails are hashed with; required if HashEmails is set. Emails are easy to guess, so
+	// unkeyed hashes could be reversed. Keep the key to correlate hashes across exports.
+	HashKey []byte
+}
+
+// validate checks the options.
+func (o *Options) validate() error {
+	if o != nil && o.HashEmails && len(o.HashKey) == 0 {
+		return ErrMissingHashKey
+	}
+
+	return nil
+}
+
+// hashEmail returns the hash of the given email according to the options. Without a hash key, the email is dropped
+// rather than exported unhashed.
+func (o *Options) hashEmail(email string) string {
+	if o == nil || !o.HashEmails || email == "" {
+		return email
+	}
+	if len(o.HashKey) == 0 {
+		return ""
+	}
+
+	mac := hmac.New(sha256.New, o.HashKey)
+	mac.Write([]byte(strings.ToLower(email)))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// value returns the value of the given string pointer, or an empty string if it's nil.
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// user sets the user fields of the record.
+func (r *Record) user(user *doppler.User, opts *Options) {
+	if user == nil {
+		return
+	}
+	r.UserName = value(user.Name)
+	r.UserEmail = opts.hashEmail(value(user.Email))
+}
+
+// ActivityLogRecord returns the record of an activity log. If opts ask for hashed emails without a hash key, the email
+// is omitted.
+func ActivityLogRecord(log *doppler.ActivityLog, opts *Options) *Record {
+	r := &Record{
+		Kind:        KindActivity,
+		ID:          value(log.ID),
+		CreatedAt:   value(log.CreatedAt),
+		Text:        value(log.Text),
+		Project:     value(log.Project),
+		Environment: value(log.Environment),
+		Config:      value(log.Config),
+	}
+	r.user(log.User, opts)
+
+	return r
+}
+
+// ConfigLogRecord returns the record of a config log. If opts ask for hashed emails without a hash key, the email is
+// omitted.
+func ConfigLogRecord(log *doppler.ConfigLog, opts *Options) *Record {
+	r := &Record{
+		Kind:        KindConfig,
+		ID:          value(log.ID),
+		CreatedAt:   value(log.CreatedAt),
+		Text:        value(log.Text),
+		Project:     value(log.Project),
+		Environment: value(log.Environment),
+		Config:      value(log.Config),
+		Rollback:    log.Rollback != nil && *log.Rollback,
+	}
+	r.user(log.User, opts)
+
+	for _, diff := range log.Diff {
+		if diff.Name != nil {
+			r.Secrets = append(r.Secrets, *diff.Name)
+		}
+	}
+	sort.Strings(r.Secrets)
+
+	return r
+}
+
+// write encodes the records of all items of the iterator and flushes the encoder. It returns the number of encoded
+// records.
+func write[T any](enc Encoder, it doppler.Iterator[T], record func(T) *Record) (int, error) {
+	var n int
+	for {
+		item, err := it.Next()
+		if errors.Is(err, doppler.ErrIteratorDone) {
+			break
+		}
+		if err != nil {
+			return n, errors.Wrap(err, "next log")
+		}
+
+		if err := enc.Encode(record(item)); err != nil {
+			return n, errors.Wrap(err, "encode record")
+		}
+		n++
+	}
+
+	if err := enc.Flush(); err != nil {
+		return n, errors.Wrap(err, "flush encoder")
+	}
+
+	return n, nil
+}
+
+// ActivityLogs writes all activity logs of the iterator to the encoder and returns the number of written logs.
+func ActivityLogs(enc Encoder, it doppler.Iterator[*doppler.ActivityLog], opts *Options) (int, error) {
+	if err := opts.validate(); err != nil {
+		return 0, err
+	}
+
+	return write(enc, it, func(log *doppler.ActivityLog) *Record { return ActivityLogRecord(log, opts) })
+}
+
+// ConfigLogs writes all config logs of the iterator to the encoder and returns the number of written logs.
+func ConfigLogs(enc Encoder, it doppler.Iterator[*doppler.ConfigLog], opts *Options) (int, error) {
+	if err := opts.validate(); err != nil {
+		return 0, err
+	}
+
+	return write(enc, it, func(log *doppler.ConfigLog) *Record { return ConfigLogRecord(log, opts) })
+}
@@ -0,0 +1,354 @@
+package export_test
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/export"
+	"github.com/nikoksr/doppler-go/pointer"
+)
+
+func testActivityLogs() []*doppler.ActivityLog {
+	return []*doppler.ActivityLog{
+		{
+			ID:          pointer.To("a1"),
+			Text:        pointer.To("Jane updated prd"),
+			HTML:        pointer.To("<b>Jane</b> updated prd"),
+			User:        &doppler.User{Name: pointer.To("Jane"), Email: pointer.To("jane@example.com")},
+			Project:     pointer.To("backend"),
+			Environment: pointer.To("prd"),
+			Config:      pointer.To("prd"),
+			CreatedAt:   pointer.To("2023-01-02T03:04:05Z"),
+		},
+		{
+			ID:        pointer.To("a2"),
+			Text:      pointer.To("Created project | \"new\""),
+			Project:   pointer.To("frontend"),
+			CreatedAt: pointer.To("2023-01-03T00:00:00Z"),
+		},
+	}
+}
+
+func testConfigLogs() []*doppler.ConfigLog {
+	return []*doppler.ConfigLog{
+		{
+			ID:   pointer.To("c1"),
+			Text: pointer.To("Rolled back secrets"),
+			HTML: pointer.To("<i>Rolled back</i>"),
+			Diff: []doppler.ConfigLogDiff{
+				{Name: pointer.To("TOKEN"), Added: pointer.To("new-value"), Removed: pointer.To("old-value")},
+				{Name: pointer.To("API_KEY"), Added: pointer.To("secret")},
+			},
+			Rollback:    pointer.To(true),
+			User:        &doppler.User{Name: pointer.To("Joe"), Email: pointer.To("joe@example.com")},
+			Project:     pointer.To("backend"),
+			Environment: pointer.To("prd"),
+			Config:      pointer.To("prd"),
+			CreatedAt:   pointer.To("2023-01-02T03:04:05Z"),
+		},
+	}
+}
+
+func hmacHex(key, s string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(s))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// octetCounted frames the messages using octet counting.
+func octetCounted(messages ...string) string {
+	var framed string
+	for _, msg := range messages {
+		framed += strconv.Itoa(len(msg)) + " " + msg
+	}
+	return framed
+}
+
+func TestActivityLogs_JSONL(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	n, err := export.ActivityLogs(export.NewJSONLEncoder(&buf), doppler.NewSliceIterator(testActivityLogs()), &export.Options{HashEmails: true, HashKey: []byte("key")})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("Expected 2 exported logs, got %d", n)
+	}
+
+	want := `{"kind":"activity","id":"a1","created_at":"2023-01-02T03:04:05Z","text":"Jane updated prd","user_name":"Jane","user_email":"` +
+		hmacHex("key", "jane@example.com") + `","project":"backend","environment":"prd","config":"prd"}` + "\n" +
+		`{"kind":"activity","id":"a2","created_at":"2023-01-03T00:00:00Z","text":"Created project | \"new\"","project":"frontend"}` + "\n"
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("Unexpected output (-want +got):\n%s", diff)
+	}
+}
+
+func TestConfigLogs_CSV(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		columns []export.Column
+		logs    []*doppler.ConfigLog
+		opts    *export.Options
+		want    string
+		wantErr error
+	}{
+		{
+			name:    "Custom columns",
+			columns: []export.Column{export.ColumnID, export.ColumnUserEmail, export.ColumnRollback, export.ColumnSecrets},
+			logs:    testConfigLogs(),
+			want:    "id,user_email,rollback,secrets\nc1,joe@example.com,true,API_KEY;TOKEN\n",
+		},
+		{
+			name: "Default columns",
+			logs: testConfigLogs(),
+			want: "kind,id,created_at,user_email,project,environment,config,text\n" +
+				"config,c1,2023-01-02T03:04:05Z,joe@example.com,backend,prd,prd,Rolled back secrets\n",
+		},
+		{
+			name:    "Keyed email hash",
+			columns: []export.Column{export.ColumnUserEmail},
+			logs:    testConfigLogs(),
+			opts:    &export.Options{HashEmails: true, HashKey: []byte("key")},
+			want:    "user_email\n" + hmacHex("key", "joe@example.com") + "\n",
+		},
+		{
+			name:    "Email hash ignores case",
+			columns: []export.Column{export.ColumnUserEmail},
+			logs:    []*doppler.ConfigLog{{User: &doppler.User{Email: pointer.To("Joe@Example.COM")}}},
+			opts:    &export.Options{HashEmails: true, HashKey: []byte("key")},
+			want:    "user_email\n" + hmacHex("key", "joe@example.com") + "\n",
+		},
+		{
+			name:    "Email hash without key",
+			columns: []export.Column{export.ColumnUserEmail},
+			logs:    testConfigLogs(),
+			opts:    &export.Options{HashEmails: true},
+			want:    "",
+			wantErr: export.ErrMissingHashKey,
+		},
+		{
+			name:    "No logs",
+			columns: []export.Column{export.ColumnID},
+			want:    "id\n",
+		},
+		{
+			name:    "Unknown column",
+			columns: []export.Column{"value"},
+			wantErr: export.ErrUnknownColumn,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			enc, err := export.NewCSVEncoder(&buf, tt.columns...)
+			if err != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Unexpected error. Expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+
+			if _, err := export.ConfigLogs(enc, doppler.NewSliceIterator(tt.logs), tt.opts); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Unexpected error. Expected %v, got %v", tt.wantErr, err)
+			}
+			if diff := cmp.Diff(tt.want, buf.String()); diff != "" {
+				t.Errorf("Unexpected output (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestActivityLogs_Syslog(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opts *export.SyslogOptions
+		want string
+	}{
+		{
+			name: "RFC 5424",
+			opts: &export.SyslogOptions{Hostname: "host"},
+			want: `<110>1 2023-01-02T03:04:05Z host doppler - activity [doppler@32473 id="a1" user="Jane" userEmail="jane@example.com" project="backend" environment="prd" config="prd"] Jane updated prd` + "\n" +
+				`<110>1 2023-01-03T00:00:00Z host doppler - activity [doppler@32473 id="a2" project="frontend"] Created project | "new"` + "\n",
+		},
+		{
+			name: "CEF with octet counting",
+			opts: &export.SyslogOptions{Format: export.SyslogCEF, Framing: export.FramingOctetCounting, Facility: 10, Severity: 5, Hostname: "host", AppName: "audit"},
+			want: octetCounted(
+				`<85>1 2023-01-02T03:04:05Z host audit - activity - CEF:0|Doppler|Doppler|v3|activity|Jane updated prd|3|externalId=a1 msg=Jane updated prd suser=jane@example.com cs1Label=project cs1=backend cs2Label=environment cs2=prd cs3Label=config cs3=prd cs5Label=userName cs5=Jane rt=1672628645000`,
+				`<85>1 2023-01-03T00:00:00Z host audit - activity - CEF:0|Doppler|Doppler|v3|activity|Created project \| "new"|3|externalId=a2 msg=Created project | "new" cs1Label=project cs1=frontend rt=1672704000000`,
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			if _, err := export.ActivityLogs(export.NewSyslogEncoder(&buf, tt.opts), doppler.NewSliceIterator(testActivityLogs()), nil); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, buf.String()); diff != "" {
+				t.Errorf("Unexpected output (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigLogs_Syslog(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	enc := export.NewSyslogEncoder(&buf, &export.SyslogOptions{Hostname: "host"})
+	if _, err := export.ConfigLogs(enc, doppler.NewSliceIterator(testConfigLogs()), nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := `<110>1 2023-01-02T03:04:05Z host doppler - config [doppler@32473 id="c1" user="Joe" userEmail="joe@example.com" project="backend" environment="prd" config="prd" secrets="API_KEY,TOKEN" rollback="true"] Rolled back secrets` + "\n"
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("Unexpected output (-want +got):\n%s", diff)
+	}
+	if strings.Contains(buf.String(), "value") || strings.Contains(buf.String(), "secret\"") {
+		t.Error("Expected secret values not to be exported")
+	}
+}
+
+func TestDialSyslog_UDP(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	defer listener.Close()
+
+	enc, err := export.DialSyslog("udp", listener.LocalAddr().String(), &export.SyslogOptions{Hostname: "host"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer enc.Close()
+
+	if _, err := export.ActivityLogs(enc, doppler.NewSliceIterator(testActivityLogs()), nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Every message is sent as a single datagram without delimiter.
+	var got []string
+	buf := make([]byte, 2048)
+	for len(got) < 2 {
+		n, _, err := listener.ReadFrom(buf)
+		if err != nil {
+			t.Fatalf("Failed to read: %v", err)
+		}
+		got = append(got, string(buf[:n]))
+	}
+
+	want := []string{
+		`<110>1 2023-01-02T03:04:05Z host doppler - activity [doppler@32473 id="a1" user="Jane" userEmail="jane@example.com" project="backend" environment="prd" config="prd"] Jane updated prd`,
+		`<110>1 2023-01-03T00:00:00Z host doppler - activity [doppler@32473 id="a2" project="frontend"] Created project | "new"`,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected messages (-want +got):\n%s", diff)
+	}
+}
+
+func TestDialSyslog_TCP(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	defer listener.Close()
+
+	received := make(chan []string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			received <- nil
+			return
+		}
+		defer conn.Close()
+
+		// Read octet counted messages until the connection is closed.
+		var messages []string
+		r := bufio.NewReader(conn)
+		for {
+			prefix, err := r.ReadString(' ')
+			if err != nil {
+				break
+			}
+			length, err := strconv.Atoi(strings.TrimSuffix(prefix, " "))
+			if err != nil {
+				break
+			}
+			msg := make([]byte, length)
+			if _, err := io.ReadFull(r, msg); err != nil {
+				break
+			}
+			messages = append(messages, string(msg))
+		}
+		received <- messages
+	}()
+
+	enc, err := export.DialSyslog("tcp", listener.Addr().String(), &export.SyslogOptions{Hostname: "host"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := export.ActivityLogs(enc, doppler.NewSliceIterator(testActivityLogs()), &export.Options{HashEmails: true, HashKey: []byte("key")}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := []string{
+		`<110>1 2023-01-02T03:04:05Z host doppler - activity [doppler@32473 id="a1" user="Jane" userEmail="` + hmacHex("key", "jane@example.com") + `" project="backend" environment="prd" config="prd"] Jane updated prd`,
+		`<110>1 2023-01-03T00:00:00Z host doppler - activity [doppler@32473 id="a2" project="frontend"] Created project | "new"`,
+	}
+	if diff := cmp.Diff(want, <-received); diff != "" {
+		t.Errorf("Unexpected messages (-want +got):\n%s", diff)
+	}
+}
+
+// failingIterator fails on its first call.
+type failingIterator struct{}
+
+func (failingIterator) Next() (*doppler.ActivityLog, error) {
+	return nil, errors.New("fetch failed")
+}
+
+func TestActivityLogs_IteratorError(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	n, err := export.ActivityLogs(export.NewJSONLEncoder(&buf), failingIterator{}, nil)
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	if n != 0 || buf.Len() != 0 {
+		t.Errorf("Expected nothing to be written, got %d logs: %q", n, buf.String())
+	}
+}
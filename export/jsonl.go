@@ -0,0 +1,26 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// JSONLEncoder writes records as JSON Lines, one JSON object per line.
+type JSONLEncoder struct {
+	enc *json.Encoder
+}
+
+// NewJSONLEncoder returns an encoder writing JSON Lines to w.
+func NewJSONLEncoder(w io.Writer) *JSONLEncoder {
+	return &JSONLEncoder{enc: json.NewEncoder(w)}
+}
+
+// Encode writes the record as a single line.
+func (e *JSONLEncoder) Encode(r *Record) error {
+	return e.enc.Encode(r)
+}
+
+// Flush does nothing, since records are written immediately.
+func (e *JSONLEncoder) Flush() error {
+	return nil
+}
@@ -0,0 +1,289 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SyslogFormat is the format of the content of a syslog message.
+type SyslogFormat int
+
+const (
+	// SyslogRFC5424 carries the record's fields as RFC 5424 structured data and its text as message.
+	SyslogRFC5424 SyslogFormat = iota
+
+	// SyslogCEF carries the record as ArcSight Common Event Format (CEF) message.
+	SyslogCEF
+)
+
+// SyslogFraming is the way syslog messages are delimited from each other.
+type SyslogFraming int
+
+const (
+	// FramingAuto uses no framing for UDP connections, octet counting for other connections and newlines otherwise.
+	FramingAuto SyslogFraming = iota
+
+	// FramingNone writes every message with a single write and without delimiter, as required for UDP (RFC 5426).
+	FramingNone
+
+	// FramingNewline terminates every message with a newline.
+	FramingNewline
+
+	// FramingOctetCounting prefixes every message with its length, as recommended for TCP (RFC 6587).
+	FramingOctetCounting
+)
+
+const (
+	// DefaultSyslogFacility is the default facility of syslog messages; log audit.
+	DefaultSyslogFacility = 13
+
+	// DefaultSyslogSeverity is the default severity of syslog messages; informational.
+	DefaultSyslogSeverity = 6
+
+	// DefaultSyslogAppName is the default application name of syslog messages.
+	DefaultSyslogAppName = "doppler"
+
+	// syslogSDID is the ID of the structured data element. 32473 is the private enterprise number reserved for
+	// documentation; receivers only need it to be stable.
+	syslogSDID = "doppler@32473"
+
+	// syslogTimestamp is the timestamp layout of RFC 5424; at most microsecond precision.
+	syslogTimestamp = "2006-01-02T15:04:05.999999Z07:00"
+)
+
+// SyslogOptions represents the options for a syslog encoder.
+type SyslogOptions struct {
+	Format   SyslogFormat  // Format of the message content. Defaults to SyslogRFC5424.
+	Framing  SyslogFraming // Framing of the messages. Defaults to FramingAuto.
+	Facility int           // Facility of the messages. Defaults to DefaultSyslogFacility if zero.
+	Severity int           // Severity of the messages. Defaults to DefaultSyslogSeverity if zero.
+	Hostname string        // Hostname of the messages. Defaults to the local hostname.
+	AppName  string        // Application name of the messages. Defaults to DefaultSyslogAppName.
+}
+
+// SyslogEncoder writes records as RFC 5424 syslog messages.
+type SyslogEncoder struct {
+	w    io.Writer
+	conn net.Conn // Set if the encoder dialed the connection itself.
+	opts SyslogOptions
+}
+
+// NewSyslogEncoder returns an encoder writing syslog messages to w. Every message is written with a single write.
+func NewSyslogEncoder(w io.Writer, opts *SyslogOptions) *SyslogEncoder {
+	e := &SyslogEncoder{w: w}
+	if opts != nil {
+		e.opts = *opts
+	}
+	if e.opts.Framing == FramingAuto {
+		e.opts.Framing = FramingNewline
+	}
+	if e.opts.Facility == 0 {
+		e.opts.Facility = DefaultSyslogFacility
+	}
+	if e.opts.Severity == 0 {
+		e.opts.Severity = DefaultSyslogSeverity
+	}
+	if e.opts.Hostname == "" {
+		e.opts.Hostname, _ = os.Hostname()
+	}
+	if e.opts.AppName == "" {
+		e.opts.AppName = DefaultSyslogAppName
+	}
+
+	return e
+}
+
+// DialSyslog connects to the syslog endpoint at the given network address, e.g. "udp" and "localhost:514", and
+// returns an encoder writing syslog messages to it. Close the encoder to close the connection.
+func DialSyslog(network, address string, opts *SyslogOptions) (*SyslogEncoder, error) {
+	conn, err := net.Dial(network, address)
+	if err != nil {
+		return nil, errors.Wrap(err, "dial syslog endpoint")
+	}
+
+	var o SyslogOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Framing == FramingAuto {
+		o.Framing = FramingOctetCounting
+		if strings.HasPrefix(network, "udp") {
+			o.Framing = FramingNone
+		}
+	}
+
+	e := NewSyslogEncoder(conn, &o)
+	e.conn = conn
+
+	return e, nil
+}
+
+// header returns the RFC 5424 header of a message for the given record.
+func (e *SyslogEncoder) header(r *Record) string {
+	timestamp := "-"
+	if t, err := time.Parse(time.RFC3339, r.CreatedAt); err == nil {
+		timestamp = t.Format(syslogTimestamp)
+	}
+
+	return fmt.Sprintf("<%d>1 %s %s %s - %s",
+		e.opts.Facility*8+e.opts.Severity,
+		timestamp,
+		headerField(e.opts.Hostname, 255),
+		headerField(e.opts.AppName, 48),
+		headerField(string(r.Kind), 32),
+	)
+}
+
+// headerField returns the value as syslog header field of the given maximum length; only printable ASCII is allowed.
+func headerField(value string, limit int) string {
+	field := strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return -1
+		}
+		return r
+	}, value)
+	if len(field) > limit {
+		field = field[:limit]
+	}
+	if field == "" {
+		return "-"
+	}
+
+	return field
+}
+
+// singleLine replaces line breaks, so that a value can't break the framing of a message.
+var singleLine = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ")
+
+// sdEscape escapes a structured data parameter value.
+var sdEscape = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+// structuredData returns the RFC 5424 structured data element of the record.
+func structuredData(r *Record) string {
+	params := []struct{ name, value string }{
+		{"id", r.ID},
+		{"user", r.UserName},
+		{"userEmail", r.UserEmail},
+		{"project", r.Project},
+		{"environment", r.Environment},
+		{"config", r.Config},
+		{"secrets", strings.Join(r.Secrets, ",")},
+	}
+	if r.Rollback {
+		params = append(params, struct{ name, value string }{"rollback", "true"})
+	}
+
+	var b strings.Builder
+	b.WriteString("[" + syslogSDID)
+	for _, p := range params {
+		if p.value != "" {
+			fmt.Fprintf(&b, ` %s="%s"`, p.name, sdEscape.Replace(singleLine.Replace(p.value)))
+		}
+	}
+	b.WriteString("]")
+
+	return b.String()
+}
+
+// cefHeaderEscape escapes a CEF header field.
+var cefHeaderEscape = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r\n", " ", "\n", " ", "\r", " ")
+
+// cefExtensionEscape escapes a CEF extension value.
+var cefExtensionEscape = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// cef returns the record as CEF message.
+func cef(r *Record) string {
+	name := r.Text
+	if name == "" {
+		name = string(r.Kind) + " log"
+	}
+
+	extensions := []struct{ key, value string }{
+		{"externalId", r.ID},
+		{"msg", r.Text},
+		{"suser", r.UserEmail},
+		{"cs1Label", "project"},
+		{"cs1", r.Project},
+		{"cs2Label", "environment"},
+		{"cs2", r.Environment},
+		{"cs3Label", "config"},
+		{"cs3", r.Config},
+		{"cs4Label", "secrets"},
+		{"cs4", strings.Join(r.Secrets, ",")},
+		{"cs5Label", "userName"},
+		{"cs5", r.UserName},
+	}
+	if t, err := time.Parse(time.RFC3339, r.CreatedAt); err == nil {
+		extensions = append(extensions, struct{ key, value string }{"rt", strconv.FormatInt(t.UnixMilli(), 10)})
+	}
+	if r.Rollback {
+		extensions = append(extensions, struct{ key, value string }{"act", "rollback"})
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "CEF:0|Doppler|Doppler|v3|%s|%s|3|", cefHeaderEscape.Replace(string(r.Kind)), cefHeaderEscape.Replace(name))
+	var n int
+	for i, ext := range extensions {
+		// Skip empty values, and labels whose value is empty.
+		if ext.value == "" || (strings.HasSuffix(ext.key, "Label") && extensions[i+1].value == "") {
+			continue
+		}
+		if n > 0 {
+			b.WriteByte(' ')
+		}
+		n++
+		b.WriteString(ext.key + "=" + cefExtensionEscape.Replace(ext.value))
+	}
+
+	return b.String()
+}
+
+// Encode writes the record as a single syslog message.
+func (e *SyslogEncoder) Encode(r *Record) error {
+	msg := e.header(r)
+	switch e.opts.Format {
+	case SyslogCEF:
+		msg += " - " + cef(r)
+	default:
+		msg += " " + structuredData(r)
+		if r.Text != "" {
+			msg += " " + singleLine.Replace(r.Text)
+		}
+	}
+
+	var buf bytes.Buffer
+	switch e.opts.Framing {
+	case FramingOctetCounting:
+		buf.WriteString(strconv.Itoa(len(msg)) + " " + msg)
+	case FramingNewline:
+		buf.WriteString(msg + "\n")
+	default:
+		buf.WriteString(msg)
+	}
+
+	_, err := e.w.Write(buf.Bytes())
+
+	return err
+}
+
+// Flush does nothing, since messages are written immediately.
+func (e *SyslogEncoder) Flush() error {
+	return nil
+}
+
+// Close closes the connection of an encoder created by DialSyslog. It does nothing for other encoders.
+func (e *SyslogEncoder) Close() error {
+	if e.conn == nil {
+		return nil
+	}
+
+	return e.conn.Close()
+}
@@ -0,0 +1,141 @@
+package doppler
+
+type (
+	// Group represents a group of workplace users.
+	Group struct {
+		Slug               *string            `json:"slug,omitempty"`                 // Unique identifier of the group.
+		Name               *string            `json:"name,omitempty"`                 // Name of the group.
+		DefaultProjectRole *ProjectMemberRole `json:"default_project_role,omitempty"` // Role the group gets when it's added to a project, if any.
+		CreatedAt          *string            `json:"created_at,omitempty"`           // Time the group was created.
+	}
+
+	// GroupMember represents a member of a group.
+	GroupMember struct {
+		Type *string `json:"type,omitempty" validate:"required,eq=workplace_user"` // Type of the member. Only workplace users can be group members.
+		Slug *string `json:"slug,omitempty" validate:"required"`                   // Unique identifier of the member.
+	}
+
+	// GroupListResponse represents a response from the group list endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/workplace/groups
+	// Docs:     https://docs.doppler.com/reference/groups-list
+	GroupListResponse struct {
+		APIResponse `json:",inline"`
+		Groups      []*Group `json:"groups"`
+	}
+
+	// GroupListOptions represents the query parameters for a group list request.
+	GroupListOptions struct {
+		ListOptions `url:",inline" json:"-"`
+	}
+
+	// GroupCreateResponse represents a response from the group create endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/workplace/groups
+	// Docs:     https://docs.doppler.com/reference/groups-create
+	GroupCreateResponse struct {
+		APIResponse `json:",inline"`
+		Group       *Group `json:"group,omitempty"`
+	}
+
+	// GroupCreateOptions represents the body parameters for a group create request.
+	GroupCreateOptions struct {
+		Name               string  `url:"-" json:"name" validate:"required"`       // Name of the group.
+		DefaultProjectRole *string `url:"-" json:"default_project_role,omitempty"` // Identifier of the role the group gets when it's added to a project.
+	}
+
+	// GroupGetResponse represents a response from the group get endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/workplace/groups/group/{slug}
+	// Docs:     https://docs.doppler.com/reference/groups-retrieve
+	GroupGetResponse struct {
+		APIResponse `json:",inline"`
+		Group       *Group `json:"group,omitempty"`
+	}
+
+	// GroupGetOptions represents the options for the group get endpoint.
+	GroupGetOptions struct {
+		Slug string `url:"-" json:"-" validate:"required"` // Unique identifier of the group.
+	}
+
+	// GroupUpdateResponse represents a response from the group update endpoint.
+	//
+	// Method:   PATCH
+	// Endpoint: https://api.doppler.com/v3/workplace/groups/group/{slug}
+	// Docs:     https://docs.doppler.com/reference/groups-update
+	GroupUpdateResponse struct {
+		APIResponse `json:",inline"`
+		Group       *Group `json:"group,omitempty"`
+	}
+
+	// GroupUpdateOptions represents the options for the group update endpoint.
+	GroupUpdateOptions struct {
+		Slug                  string  `url:"-" json:"-" validate:"required"`                                                            // Unique identifier of the group.
+		NewName               *string `url:"-" json:"name,omitempty" validate:"required_without=NewDefaultProjectRole,omitempty,min=1"` // New name of the group.
+		NewDefaultProjectRole *string `url:"-" json:"default_project_role,omitempty" validate:"required_without=NewName"`               // Identifier of the new default project role.
+	}
+
+	// GroupDeleteResponse represents a response from the group delete endpoint.
+	//
+	// Method:   DELETE
+	// Endpoint: https://api.doppler.com/v3/workplace/groups/group/{slug}
+	// Docs:     https://docs.doppler.com/reference/groups-delete
+	GroupDeleteResponse struct {
+		APIResponse `json:",inline"`
+	}
+
+	// GroupDeleteOptions represents the options for the group delete endpoint.
+	GroupDeleteOptions struct {
+		Slug string `url:"-" json:"-" validate:"required"` // Unique identifier of the group.
+	}
+
+	// GroupMemberListResponse represents a response from the group member list endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/workplace/groups/group/{slug}/members
+	// Docs:     https://docs.doppler.com/reference/groups-list-members
+	GroupMemberListResponse struct {
+		APIResponse `json:",inline"`
+		Members     []*GroupMember `json:"members"`
+	}
+
+	// GroupMemberListOptions represents the options for the group member list endpoint.
+	GroupMemberListOptions struct {
+		ListOptions `url:",inline" json:"-"`
+		Group       string `url:"-" json:"-" validate:"required"` // Unique identifier of the group.
+	}
+
+	// GroupMemberAddResponse represents a response from the group member add endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/workplace/groups/group/{slug}/members
+	// Docs:     https://docs.doppler.com/reference/groups-add-member
+	GroupMemberAddResponse struct {
+		APIResponse `json:",inline"`
+	}
+
+	// GroupMemberAddOptions represents the options for the group member add endpoint.
+	GroupMemberAddOptions struct {
+		Group   string        `url:"-" json:"-" validate:"required"`                  // Unique identifier of the group.
+		Members []GroupMember `url:"-" json:"members" validate:"required,min=1,dive"` // Members to add to the group.
+	}
+
+	// GroupMemberRemoveResponse represents a response from the group member remove endpoint.
+	//
+	// Method:   DELETE
+	// Endpoint: https://api.doppler.com/v3/workplace/groups/group/{slug}/members/{type}/{member_slug}
+	// Docs:     https://docs.doppler.com/reference/groups-delete-member
+	GroupMemberRemoveResponse struct {
+		APIResponse `json:",inline"`
+	}
+
+	// GroupMemberRemoveOptions represents the options for the group member remove endpoint.
+	GroupMemberRemoveOptions struct {
+		Group      string `url:"-" json:"-" validate:"required"`                   // Unique identifier of the group.
+		MemberType string `url:"-" json:"-" validate:"required,eq=workplace_user"` // Type of the member.
+		MemberSlug string `url:"-" json:"-" validate:"required"`                   // Unique identifier of the member.
+	}
+)
@@ -0,0 +1,231 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/nikoksr/doppler-go"
+)
+
+// Client is the client used to invoke /v3/workplace/groups APIs.
+type Client struct {
+	Backend doppler.Backend
+	Key     string
+}
+
+// Default returns a new client based on the SDK's default backend and API key.
+func Default() *Client {
+	return &Client{
+		Backend: doppler.GetBackend(),
+		Key:     doppler.Key,
+	}
+}
+
+// groupPath returns the path of the group with the given slug.
+func groupPath(slug string) string {
+	return fmt.Sprintf("/v3/workplace/groups/group/%s", url.PathEscape(slug))
+}
+
+func (c Client) list(ctx context.Context, opts *doppler.GroupListOptions) ([]*doppler.Group, doppler.APIResponse, error) {
+	var resp doppler.GroupListResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    "/v3/workplace/groups",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Groups, resp.APIResponse, err
+}
+
+// List returns a list of groups.
+func (c Client) List(ctx context.Context, opts *doppler.GroupListOptions) ([]*doppler.Group, doppler.APIResponse, error) {
+	return c.list(ctx, opts)
+}
+
+// List returns a list of groups using the default client.
+func List(ctx context.Context, opts *doppler.GroupListOptions) ([]*doppler.Group, doppler.APIResponse, error) {
+	return Default().List(ctx, opts)
+}
+
+func (c Client) create(ctx context.Context, opts *doppler.GroupCreateOptions) (*doppler.Group, doppler.APIResponse, error) {
+	var resp doppler.GroupCreateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/workplace/groups",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Group, resp.APIResponse, err
+}
+
+// Create creates a new group.
+func (c Client) Create(ctx context.Context, opts *doppler.GroupCreateOptions) (*doppler.Group, doppler.APIResponse, error) {
+	return c.create(ctx, opts)
+}
+
+// Create creates a new group using the default client.
+func Create(ctx context.Context, opts *doppler.GroupCreateOptions) (*doppler.Group, doppler.APIResponse, error) {
+	return Default().Create(ctx, opts)
+}
+
+func (c Client) get(ctx context.Context, opts *doppler.GroupGetOptions) (*doppler.Group, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.GroupGetResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    groupPath(opts.Slug),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Group, resp.APIResponse, err
+}
+
+// Get returns a group.
+func (c Client) Get(ctx context.Context, opts *doppler.GroupGetOptions) (*doppler.Group, doppler.APIResponse, error) {
+	return c.get(ctx, opts)
+}
+
+// Get returns a group using the default client.
+func Get(ctx context.Context, opts *doppler.GroupGetOptions) (*doppler.Group, doppler.APIResponse, error) {
+	return Default().Get(ctx, opts)
+}
+
+func (c Client) update(ctx context.Context, opts *doppler.GroupUpdateOptions) (*doppler.Group, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.GroupUpdateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPatch,
+		Path:    groupPath(opts.Slug),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Group, resp.APIResponse, err
+}
+
+// Update updates the name and/or the default project role of a group.
+func (c Client) Update(ctx context.Context, opts *doppler.GroupUpdateOptions) (*doppler.Group, doppler.APIResponse, error) {
+	return c.update(ctx, opts)
+}
+
+// Update updates the name and/or the default project role of a group using the default client.
+func Update(ctx context.Context, opts *doppler.GroupUpdateOptions) (*doppler.Group, doppler.APIResponse, error) {
+	return Default().Update(ctx, opts)
+}
+
+func (c Client) delete(ctx context.Context, opts *doppler.GroupDeleteOptions) (doppler.APIResponse, error) {
+	if opts == nil {
+		return doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.GroupDeleteResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodDelete,
+		Path:    groupPath(opts.Slug),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.APIResponse, err
+}
+
+// Delete deletes a group.
+func (c Client) Delete(ctx context.Context, opts *doppler.GroupDeleteOptions) (doppler.APIResponse, error) {
+	return c.delete(ctx, opts)
+}
+
+// Delete deletes a group using the default client.
+func Delete(ctx context.Context, opts *doppler.GroupDeleteOptions) (doppler.APIResponse, error) {
+	return Default().Delete(ctx, opts)
+}
+
+func (c Client) memberList(ctx context.Context, opts *doppler.GroupMemberListOptions) ([]*doppler.GroupMember, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.GroupMemberListResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    groupPath(opts.Group) + "/members",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Members, resp.APIResponse, err
+}
+
+// MemberList returns a list of members of a group.
+func (c Client) MemberList(ctx context.Context, opts *doppler.GroupMemberListOptions) ([]*doppler.GroupMember, doppler.APIResponse, error) {
+	return c.memberList(ctx, opts)
+}
+
+// MemberList returns a list of members of a group using the default client.
+func MemberList(ctx context.Context, opts *doppler.GroupMemberListOptions) ([]*doppler.GroupMember, doppler.APIResponse, error) {
+	return Default().MemberList(ctx, opts)
+}
+
+func (c Client) memberAdd(ctx context.Context, opts *doppler.GroupMemberAddOptions) (doppler.APIResponse, error) {
+	if opts == nil {
+		return doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.GroupMemberAddResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    groupPath(opts.Group) + "/members",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.APIResponse, err
+}
+
+// MemberAdd adds one or more workplace users to a group.
+func (c Client) MemberAdd(ctx context.Context, opts *doppler.GroupMemberAddOptions) (doppler.APIResponse, error) {
+	return c.memberAdd(ctx, opts)
+}
+
+// MemberAdd adds one or more workplace users to a group using the default client.
+func MemberAdd(ctx context.Context, opts *doppler.GroupMemberAddOptions) (doppler.APIResponse, error) {
+	return Default().MemberAdd(ctx, opts)
+}
+
+func (c Client) memberRemove(ctx context.Context, opts *doppler.GroupMemberRemoveOptions) (doppler.APIResponse, error) {
+	if opts == nil {
+		return doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	path := fmt.Sprintf("%s/members/%s/%s", groupPath(opts.Group), url.PathEscape(opts.MemberType), url.PathEscape(opts.MemberSlug))
+
+	var resp doppler.GroupMemberRemoveResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodDelete,
+		Path:    path,
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.APIResponse, err
+}
+
+// MemberRemove removes a member from a group.
+func (c Client) MemberRemove(ctx context.Context, opts *doppler.GroupMemberRemoveOptions) (doppler.APIResponse, error) {
+	return c.memberRemove(ctx, opts)
+}
+
+// MemberRemove removes a member from a group using the default client.
+func MemberRemove(ctx context.Context, opts *doppler.GroupMemberRemoveOptions) (doppler.APIResponse, error) {
+	return Default().MemberRemove(ctx, opts)
+}
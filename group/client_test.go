@@ -0,0 +1,845 @@
+package group_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/group"
+	"github.com/nikoksr/doppler-go/pointer"
+)
+
+func TestDefault(t *testing.T) {
+	t.Parallel()
+
+	client := group.Default()
+	if client == nil {
+		t.Fatal("Expected client to be set")
+	}
+	if client.Backend == nil {
+		t.Fatal("Expected client backend to be set")
+	}
+	if client.Key != doppler.Key {
+		t.Fatalf("Expected client key to be %q, got %q", doppler.Key, client.Key)
+	}
+}
+
+func TestGroup_List(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.GroupListOptions
+		wantGroups   []*doppler.Group
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "List groups",
+			options: &doppler.GroupListOptions{ListOptions: doppler.ListOptions{Page: 1, PerPage: 2}},
+			wantGroups: []*doppler.Group{
+				{
+					Slug:               pointer.To("engineering"),
+					Name:               pointer.To("Engineering"),
+					DefaultProjectRole: &doppler.ProjectMemberRole{Identifier: pointer.To("collaborator")},
+					CreatedAt:          pointer.To("2021-01-01T00:00:00.000Z"),
+				},
+				{
+					Slug:      pointer.To("support"),
+					Name:      pointer.To("Support"),
+					CreatedAt: pointer.To("2021-01-02T00:00:00.000Z"),
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:       "List groups with error",
+			options:    nil,
+			wantGroups: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"error"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/workplace/groups" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.GroupListResponse{
+					Groups:      tt.wantGroups,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &group.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotGroups, gotResponse, err := client.List(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the groups are expected.
+			if diff := cmp.Diff(tt.wantGroups, gotGroups); diff != "" {
+				t.Errorf("Unexpected groups (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGroup_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.GroupCreateOptions
+		wantBody     map[string]any
+		wantGroup    *doppler.Group
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "Create group",
+			options: &doppler.GroupCreateOptions{
+				Name:               "Engineering",
+				DefaultProjectRole: pointer.To("collaborator"),
+			},
+			wantBody: map[string]any{"name": "Engineering", "default_project_role": "collaborator"},
+			wantGroup: &doppler.Group{
+				Slug:               pointer.To("engineering"),
+				Name:               pointer.To("Engineering"),
+				DefaultProjectRole: &doppler.ProjectMemberRole{Identifier: pointer.To("collaborator")},
+				CreatedAt:          pointer.To("2021-01-01T00:00:00.000Z"),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:      "Create group with error",
+			options:   &doppler.GroupCreateOptions{Name: "Engineering"},
+			wantBody:  map[string]any{"name": "Engineering"},
+			wantGroup: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "409 Conflict",
+				StatusCode: http.StatusConflict,
+				Messages:   []string{"A group with this name already exists"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Create group without name",
+			options:      &doppler.GroupCreateOptions{},
+			wantGroup:    nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/workplace/groups" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.GroupCreateResponse{
+					Group:       tt.wantGroup,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &group.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotGroup, gotResponse, err := client.Create(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the group is expected.
+			if diff := cmp.Diff(tt.wantGroup, gotGroup); diff != "" {
+				t.Errorf("Unexpected group (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGroup_Get(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.GroupGetOptions
+		wantGroup    *doppler.Group
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "Get group",
+			options: &doppler.GroupGetOptions{Slug: "engineering"},
+			wantGroup: &doppler.Group{
+				Slug:      pointer.To("engineering"),
+				Name:      pointer.To("Engineering"),
+				CreatedAt: pointer.To("2021-01-01T00:00:00.000Z"),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:      "Get group with error",
+			options:   &doppler.GroupGetOptions{Slug: "engineering"},
+			wantGroup: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Group not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Get group without options",
+			options:      nil,
+			wantGroup:    nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/workplace/groups/group/engineering" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.GroupGetResponse{
+					Group:       tt.wantGroup,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &group.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotGroup, gotResponse, err := client.Get(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the group is expected.
+			if diff := cmp.Diff(tt.wantGroup, gotGroup); diff != "" {
+				t.Errorf("Unexpected group (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGroup_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.GroupUpdateOptions
+		wantBody     map[string]any
+		wantGroup    *doppler.Group
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "Update default project role",
+			options: &doppler.GroupUpdateOptions{
+				Slug:                  "engineering",
+				NewDefaultProjectRole: pointer.To("viewer"),
+			},
+			wantBody: map[string]any{"default_project_role": "viewer"},
+			wantGroup: &doppler.Group{
+				Slug:               pointer.To("engineering"),
+				Name:               pointer.To("Engineering"),
+				DefaultProjectRole: &doppler.ProjectMemberRole{Identifier: pointer.To("viewer")},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Update name with error",
+			options: &doppler.GroupUpdateOptions{
+				Slug:    "engineering",
+				NewName: pointer.To("Support"),
+			},
+			wantBody:  map[string]any{"name": "Support"},
+			wantGroup: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "409 Conflict",
+				StatusCode: http.StatusConflict,
+				Messages:   []string{"A group with this name already exists"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Update without changes",
+			options:      &doppler.GroupUpdateOptions{Slug: "engineering"},
+			wantGroup:    nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch || r.URL.Path != "/v3/workplace/groups/group/engineering" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.GroupUpdateResponse{
+					Group:       tt.wantGroup,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &group.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotGroup, gotResponse, err := client.Update(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the group is expected.
+			if diff := cmp.Diff(tt.wantGroup, gotGroup); diff != "" {
+				t.Errorf("Unexpected group (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGroup_Delete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.GroupDeleteOptions
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "Delete group",
+			options: &doppler.GroupDeleteOptions{Slug: "engineering"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Delete group with error",
+			options: &doppler.GroupDeleteOptions{Slug: "engineering"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Group not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Delete group without options",
+			options:      nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/v3/workplace/groups/group/engineering" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.GroupDeleteResponse{
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &group.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotResponse, err := client.Delete(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGroup_MemberList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.GroupMemberListOptions
+		wantMembers  []*doppler.GroupMember
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "List members",
+			options: &doppler.GroupMemberListOptions{Group: "engineering"},
+			wantMembers: []*doppler.GroupMember{
+				{Type: pointer.To(doppler.MemberTypeWorkplaceUser), Slug: pointer.To("jane")},
+				{Type: pointer.To(doppler.MemberTypeWorkplaceUser), Slug: pointer.To("john")},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:        "List members with error",
+			options:     &doppler.GroupMemberListOptions{Group: "engineering"},
+			wantMembers: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Group not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "List members without options",
+			options:      nil,
+			wantMembers:  nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/workplace/groups/group/engineering/members" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.GroupMemberListResponse{
+					Members:     tt.wantMembers,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &group.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotMembers, gotResponse, err := client.MemberList(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the members are expected.
+			if diff := cmp.Diff(tt.wantMembers, gotMembers); diff != "" {
+				t.Errorf("Unexpected members (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGroup_MemberAdd(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.GroupMemberAddOptions
+		wantBody     map[string]any
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "Add members",
+			options: &doppler.GroupMemberAddOptions{
+				Group: "engineering",
+				Members: []doppler.GroupMember{
+					{Type: pointer.To(doppler.MemberTypeWorkplaceUser), Slug: pointer.To("jane")},
+					{Type: pointer.To(doppler.MemberTypeWorkplaceUser), Slug: pointer.To("john")},
+				},
+			},
+			wantBody: map[string]any{
+				"members": []any{
+					map[string]any{"type": "workplace_user", "slug": "jane"},
+					map[string]any{"type": "workplace_user", "slug": "john"},
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Add member with error",
+			options: &doppler.GroupMemberAddOptions{
+				Group: "engineering",
+				Members: []doppler.GroupMember{
+					{Type: pointer.To(doppler.MemberTypeWorkplaceUser), Slug: pointer.To("unknown")},
+				},
+			},
+			wantBody: map[string]any{
+				"members": []any{
+					map[string]any{"type": "workplace_user", "slug": "unknown"},
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"User not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Add service account",
+			options: &doppler.GroupMemberAddOptions{
+				Group: "engineering",
+				Members: []doppler.GroupMember{
+					{Type: pointer.To(doppler.MemberTypeServiceAccount), Slug: pointer.To("ci")},
+				},
+			},
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+		{
+			name:         "Add no members",
+			options:      &doppler.GroupMemberAddOptions{Group: "engineering"},
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/workplace/groups/group/engineering/members" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.GroupMemberAddResponse{
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &group.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotResponse, err := client.MemberAdd(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGroup_MemberRemove(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.GroupMemberRemoveOptions
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "Remove member",
+			options: &doppler.GroupMemberRemoveOptions{
+				Group:      "engineering",
+				MemberType: doppler.MemberTypeWorkplaceUser,
+				MemberSlug: "jane",
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Remove member with error",
+			options: &doppler.GroupMemberRemoveOptions{
+				Group:      "engineering",
+				MemberType: doppler.MemberTypeWorkplaceUser,
+				MemberSlug: "jane",
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Member not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Remove member without options",
+			options:      nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/v3/workplace/groups/group/engineering/members/workplace_user/jane" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.GroupMemberRemoveResponse{
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &group.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotResponse, err := client.MemberRemove(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
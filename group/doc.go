@@ -0,0 +1,26 @@
+/*
+Package group provides a client for the Doppler API's group endpoints.
+
+API-Docs: https://docs.doppler.com/reference/groups-list
+
+Example:
+
+	// Create a group and add a workplace user to it
+	g, _, err := group.Create(context.Background(), &doppler.GroupCreateOptions{
+		Name: "Engineering",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = group.MemberAdd(context.Background(), &doppler.GroupMemberAddOptions{
+		Group: *g.Slug,
+		Members: []doppler.GroupMember{
+			{Type: pointer.To(doppler.MemberTypeWorkplaceUser), Slug: pointer.To("jane")},
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+*/
+package group
@@ -0,0 +1,103 @@
+package doppler
+
+type (
+	// IntegrationType represents a type of integration Doppler can sync secrets to, e.g. AWS Secrets Manager.
+	IntegrationType struct {
+		Type *string `json:"type,omitempty"` // Identifier of the integration type, e.g. "aws_secrets_manager".
+		Name *string `json:"name,omitempty"` // Human-readable name of the integration type.
+		Kind *string `json:"kind,omitempty"` // Kind of the integration type, e.g. "secrets_manager" or "ci".
+	}
+
+	// Integration represents a configured integration of a workplace.
+	Integration struct {
+		Slug    *string `json:"slug,omitempty"`    // Unique identifier of the integration.
+		Name    *string `json:"name,omitempty"`    // Name of the integration.
+		Type    *string `json:"type,omitempty"`    // Identifier of the integration type.
+		Kind    *string `json:"kind,omitempty"`    // Kind of the integration type.
+		Enabled *bool   `json:"enabled,omitempty"` // Whether the integration is enabled.
+		Syncs   []*Sync `json:"syncs,omitempty"`   // Syncs using the integration.
+	}
+
+	// IntegrationTypeListResponse represents a response from the integration type list endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/integrations/types
+	// Docs:     https://docs.doppler.com/reference/integrations-types
+	IntegrationTypeListResponse struct {
+		APIResponse `json:",inline"`
+		Types       []*IntegrationType `json:"types"`
+	}
+
+	// IntegrationListResponse represents a response from the integration list endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/integrations
+	// Docs:     https://docs.doppler.com/reference/integrations-list
+	IntegrationListResponse struct {
+		APIResponse  `json:",inline"`
+		Integrations []*Integration `json:"integrations"`
+	}
+
+	// IntegrationGetResponse represents a response from the integration get endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/integrations/integration
+	// Docs:     https://docs.doppler.com/reference/integrations-get
+	IntegrationGetResponse struct {
+		APIResponse `json:",inline"`
+		Integration *Integration `json:"integration,omitempty"`
+	}
+
+	// IntegrationGetOptions represents the options for the integration get endpoint.
+	IntegrationGetOptions struct {
+		Slug string `url:"integration" json:"-" validate:"required"` // Unique identifier of the integration.
+	}
+
+	// IntegrationCreateResponse represents a response from the integration create endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/integrations
+	// Docs:     https://docs.doppler.com/reference/integrations-create
+	IntegrationCreateResponse struct {
+		APIResponse `json:",inline"`
+		Integration *Integration `json:"integration,omitempty"`
+	}
+
+	// IntegrationCreateOptions represents the options for the integration create endpoint.
+	IntegrationCreateOptions struct {
+		Name string         `url:"-" json:"name" validate:"required"` // Name of the integration.
+		Type string         `url:"-" json:"type" validate:"required"` // Identifier of the integration type.
+		Data map[string]any `url:"-" json:"data,omitempty"`           // Type specific data of the integration, e.g. credentials.
+	}
+
+	// IntegrationUpdateResponse represents a response from the integration update endpoint.
+	//
+	// Method:   PUT
+	// Endpoint: https://api.doppler.com/v3/integrations/integration
+	// Docs:     https://docs.doppler.com/reference/integrations-update
+	IntegrationUpdateResponse struct {
+		APIResponse `json:",inline"`
+		Integration *Integration `json:"integration,omitempty"`
+	}
+
+	// IntegrationUpdateOptions represents the options for the integration update endpoint.
+	IntegrationUpdateOptions struct {
+		Slug    string         `url:"integration" json:"-" validate:"required"`                                    // Unique identifier of the integration.
+		NewName *string        `url:"-" json:"name,omitempty" validate:"required_without=NewData,omitempty,min=1"` // New name of the integration.
+		NewData map[string]any `url:"-" json:"data,omitempty" validate:"required_without=NewName"`                 // New type specific data of the integration.
+	}
+
+	// IntegrationDeleteResponse represents a response from the integration delete endpoint.
+	//
+	// Method:   DELETE
+	// Endpoint: https://api.doppler.com/v3/integrations/integration
+	// Docs:     https://docs.doppler.com/reference/integrations-delete
+	IntegrationDeleteResponse struct {
+		APIResponse `json:",inline"`
+	}
+
+	// IntegrationDeleteOptions represents the options for the integration delete endpoint.
+	IntegrationDeleteOptions struct {
+		Slug string `url:"integration" json:"-" validate:"required"` // Unique identifier of the integration.
+	}
+)
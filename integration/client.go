@@ -0,0 +1,152 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/nikoksr/doppler-go"
+)
+
+// Client is the client used to invoke /v3/integrations APIs.
+type Client struct {
+	Backend doppler.Backend
+	Key     string
+}
+
+// Default returns a new client based on the SDK's default backend and API key.
+func Default() *Client {
+	return &Client{
+		Backend: doppler.GetBackend(),
+		Key:     doppler.Key,
+	}
+}
+
+func (c Client) types(ctx context.Context) ([]*doppler.IntegrationType, doppler.APIResponse, error) {
+	var resp doppler.IntegrationTypeListResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method: http.MethodGet,
+		Path:   "/v3/integrations/types",
+		Key:    c.Key,
+	}, &resp)
+
+	return resp.Types, resp.APIResponse, err
+}
+
+// Types returns the types of integrations Doppler can sync secrets to.
+func (c Client) Types(ctx context.Context) ([]*doppler.IntegrationType, doppler.APIResponse, error) {
+	return c.types(ctx)
+}
+
+// Types returns the types of integrations Doppler can sync secrets to using the default client.
+func Types(ctx context.Context) ([]*doppler.IntegrationType, doppler.APIResponse, error) {
+	return Default().Types(ctx)
+}
+
+func (c Client) list(ctx context.Context) ([]*doppler.Integration, doppler.APIResponse, error) {
+	var resp doppler.IntegrationListResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method: http.MethodGet,
+		Path:   "/v3/integrations",
+		Key:    c.Key,
+	}, &resp)
+
+	return resp.Integrations, resp.APIResponse, err
+}
+
+// List returns a list of integrations of the workplace.
+func (c Client) List(ctx context.Context) ([]*doppler.Integration, doppler.APIResponse, error) {
+	return c.list(ctx)
+}
+
+// List returns a list of integrations of the workplace using the default client.
+func List(ctx context.Context) ([]*doppler.Integration, doppler.APIResponse, error) {
+	return Default().List(ctx)
+}
+
+func (c Client) get(ctx context.Context, opts *doppler.IntegrationGetOptions) (*doppler.Integration, doppler.APIResponse, error) {
+	var resp doppler.IntegrationGetResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    "/v3/integrations/integration",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Integration, resp.APIResponse, err
+}
+
+// Get returns an integration.
+func (c Client) Get(ctx context.Context, opts *doppler.IntegrationGetOptions) (*doppler.Integration, doppler.APIResponse, error) {
+	return c.get(ctx, opts)
+}
+
+// Get returns an integration using the default client.
+func Get(ctx context.Context, opts *doppler.IntegrationGetOptions) (*doppler.Integration, doppler.APIResponse, error) {
+	return Default().Get(ctx, opts)
+}
+
+func (c Client) create(ctx context.Context, opts *doppler.IntegrationCreateOptions) (*doppler.Integration, doppler.APIResponse, error) {
+	var resp doppler.IntegrationCreateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/integrations",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Integration, resp.APIResponse, err
+}
+
+// Create creates a new integration.
+func (c Client) Create(ctx context.Context, opts *doppler.IntegrationCreateOptions) (*doppler.Integration, doppler.APIResponse, error) {
+	return c.create(ctx, opts)
+}
+
+// Create creates a new integration using the default client.
+func Create(ctx context.Context, opts *doppler.IntegrationCreateOptions) (*doppler.Integration, doppler.APIResponse, error) {
+	return Default().Create(ctx, opts)
+}
+
+func (c Client) update(ctx context.Context, opts *doppler.IntegrationUpdateOptions) (*doppler.Integration, doppler.APIResponse, error) {
+	var resp doppler.IntegrationUpdateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPut,
+		Path:    "/v3/integrations/integration",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Integration, resp.APIResponse, err
+}
+
+// Update updates the name and/or the data of an integration.
+func (c Client) Update(ctx context.Context, opts *doppler.IntegrationUpdateOptions) (*doppler.Integration, doppler.APIResponse, error) {
+	return c.update(ctx, opts)
+}
+
+// Update updates the name and/or the data of an integration using the default client.
+func Update(ctx context.Context, opts *doppler.IntegrationUpdateOptions) (*doppler.Integration, doppler.APIResponse, error) {
+	return Default().Update(ctx, opts)
+}
+
+func (c Client) delete(ctx context.Context, opts *doppler.IntegrationDeleteOptions) (doppler.APIResponse, error) {
+	var resp doppler.IntegrationDeleteResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodDelete,
+		Path:    "/v3/integrations/integration",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.APIResponse, err
+}
+
+// Delete deletes an integration. Its syncs are deleted as well.
+func (c Client) Delete(ctx context.Context, opts *doppler.IntegrationDeleteOptions) (doppler.APIResponse, error) {
+	return c.delete(ctx, opts)
+}
+
+// Delete deletes an integration. Its syncs are deleted as well using the default client.
+func Delete(ctx context.Context, opts *doppler.IntegrationDeleteOptions) (doppler.APIResponse, error) {
+	return Default().Delete(ctx, opts)
+}
@@ -0,0 +1,639 @@
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/integration"
+	"github.com/nikoksr/doppler-go/pointer"
+)
+
+func TestDefault(t *testing.T) {
+	t.Parallel()
+
+	client := integration.Default()
+	if client == nil {
+		t.Fatal("Expected client to be set")
+	}
+	if client.Backend == nil {
+		t.Fatal("Expected client backend to be set")
+	}
+	if client.Key != doppler.Key {
+		t.Fatalf("Expected client key to be %q, got %q", doppler.Key, client.Key)
+	}
+}
+
+func TestIntegration_Types(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		wantTypes    []*doppler.IntegrationType
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "List integration types",
+			wantTypes: []*doppler.IntegrationType{
+				{Type: pointer.To("aws_secrets_manager"), Name: pointer.To("AWS Secrets Manager"), Kind: pointer.To("secrets_manager")},
+				{Type: pointer.To("github"), Name: pointer.To("GitHub Actions"), Kind: pointer.To("ci")},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:      "List integration types with error",
+			wantTypes: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "401 Unauthorized",
+				StatusCode: http.StatusUnauthorized,
+				Messages:   []string{"Invalid Auth token"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/integrations/types" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.IntegrationTypeListResponse{
+					Types:       tt.wantTypes,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &integration.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotTypes, gotResponse, err := client.Types(context.Background())
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantTypes, gotTypes); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntegration_List(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		wantIntegrations []*doppler.Integration
+		wantResponse     doppler.APIResponse
+		wantErr          bool
+	}{
+		{
+			name: "List integrations",
+			wantIntegrations: []*doppler.Integration{
+				{
+					Slug:    pointer.To("aws-prod"),
+					Name:    pointer.To("AWS Prod"),
+					Type:    pointer.To("aws_secrets_manager"),
+					Kind:    pointer.To("secrets_manager"),
+					Enabled: pointer.To(true),
+					Syncs: []*doppler.Sync{
+						{Slug: pointer.To("sync-1"), Project: pointer.To("backend"), Config: pointer.To("prd"), Enabled: pointer.To(true)},
+					},
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:             "List integrations with error",
+			wantIntegrations: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "403 Forbidden",
+				StatusCode: http.StatusForbidden,
+				Messages:   []string{"Missing permission"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/integrations" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.IntegrationListResponse{
+					Integrations: tt.wantIntegrations,
+					APIResponse:  tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &integration.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotIntegrations, gotResponse, err := client.List(context.Background())
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantIntegrations, gotIntegrations); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntegration_Get(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		options         *doppler.IntegrationGetOptions
+		wantIntegration *doppler.Integration
+		wantResponse    doppler.APIResponse
+		wantErr         bool
+	}{
+		{
+			name:    "Get integration",
+			options: &doppler.IntegrationGetOptions{Slug: "aws-prod"},
+			wantIntegration: &doppler.Integration{
+				Slug:    pointer.To("aws-prod"),
+				Name:    pointer.To("AWS Prod"),
+				Type:    pointer.To("aws_secrets_manager"),
+				Kind:    pointer.To("secrets_manager"),
+				Enabled: pointer.To(true),
+				Syncs: []*doppler.Sync{
+					{Slug: pointer.To("sync-1"), Project: pointer.To("backend"), Config: pointer.To("prd"), Enabled: pointer.To(true)},
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:            "Get integration with error",
+			options:         &doppler.IntegrationGetOptions{Slug: "aws-prod"},
+			wantIntegration: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Integration not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:            "Get integration without options",
+			options:         nil,
+			wantIntegration: nil,
+			wantResponse:    doppler.APIResponse{},
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/integrations/integration" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if got := r.URL.Query().Get("integration"); tt.options != nil && got != tt.options.Slug {
+					t.Errorf("Unexpected integration query parameter: %q", got)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.IntegrationGetResponse{
+					Integration: tt.wantIntegration,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &integration.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotIntegration, gotResponse, err := client.Get(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantIntegration, gotIntegration); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntegration_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		options         *doppler.IntegrationCreateOptions
+		wantBody        map[string]any
+		wantIntegration *doppler.Integration
+		wantResponse    doppler.APIResponse
+		wantErr         bool
+	}{
+		{
+			name: "Create integration",
+			options: &doppler.IntegrationCreateOptions{
+				Name: "AWS Prod",
+				Type: "aws_secrets_manager",
+				Data: map[string]any{"aws_iam_role": "arn:aws:iam::123:role/doppler"},
+			},
+			wantBody: map[string]any{
+				"name": "AWS Prod",
+				"type": "aws_secrets_manager",
+				"data": map[string]any{"aws_iam_role": "arn:aws:iam::123:role/doppler"},
+			},
+			wantIntegration: &doppler.Integration{
+				Slug:    pointer.To("aws-prod"),
+				Name:    pointer.To("AWS Prod"),
+				Type:    pointer.To("aws_secrets_manager"),
+				Kind:    pointer.To("secrets_manager"),
+				Enabled: pointer.To(true),
+				Syncs: []*doppler.Sync{
+					{Slug: pointer.To("sync-1"), Project: pointer.To("backend"), Config: pointer.To("prd"), Enabled: pointer.To(true)},
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:            "Create integration with error",
+			options:         &doppler.IntegrationCreateOptions{Name: "AWS Prod", Type: "unknown"},
+			wantBody:        map[string]any{"name": "AWS Prod", "type": "unknown"},
+			wantIntegration: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"Invalid integration type"},
+			},
+			wantErr: true,
+		},
+		{
+			name:            "Create integration without type",
+			options:         &doppler.IntegrationCreateOptions{Name: "AWS Prod"},
+			wantIntegration: nil,
+			wantResponse:    doppler.APIResponse{},
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/integrations" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.IntegrationCreateResponse{
+					Integration: tt.wantIntegration,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &integration.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotIntegration, gotResponse, err := client.Create(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantIntegration, gotIntegration); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntegration_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		options         *doppler.IntegrationUpdateOptions
+		wantBody        map[string]any
+		wantIntegration *doppler.Integration
+		wantResponse    doppler.APIResponse
+		wantErr         bool
+	}{
+		{
+			name: "Update integration data",
+			options: &doppler.IntegrationUpdateOptions{
+				Slug:    "aws-prod",
+				NewData: map[string]any{"aws_iam_role": "arn:aws:iam::456:role/doppler"},
+			},
+			wantBody: map[string]any{"data": map[string]any{"aws_iam_role": "arn:aws:iam::456:role/doppler"}},
+			wantIntegration: &doppler.Integration{
+				Slug:    pointer.To("aws-prod"),
+				Name:    pointer.To("AWS Prod"),
+				Type:    pointer.To("aws_secrets_manager"),
+				Kind:    pointer.To("secrets_manager"),
+				Enabled: pointer.To(true),
+				Syncs: []*doppler.Sync{
+					{Slug: pointer.To("sync-1"), Project: pointer.To("backend"), Config: pointer.To("prd"), Enabled: pointer.To(true)},
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:            "Update integration name with error",
+			options:         &doppler.IntegrationUpdateOptions{Slug: "aws-prod", NewName: pointer.To("AWS")},
+			wantBody:        map[string]any{"name": "AWS"},
+			wantIntegration: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Integration not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:            "Update integration without changes",
+			options:         &doppler.IntegrationUpdateOptions{Slug: "aws-prod"},
+			wantIntegration: nil,
+			wantResponse:    doppler.APIResponse{},
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut || r.URL.Path != "/v3/integrations/integration" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if got := r.URL.Query().Get("integration"); tt.options != nil && got != tt.options.Slug {
+					t.Errorf("Unexpected integration query parameter: %q", got)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.IntegrationUpdateResponse{
+					Integration: tt.wantIntegration,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &integration.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotIntegration, gotResponse, err := client.Update(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantIntegration, gotIntegration); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntegration_Delete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.IntegrationDeleteOptions
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "Delete integration",
+			options: &doppler.IntegrationDeleteOptions{Slug: "aws-prod"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Delete integration with error",
+			options: &doppler.IntegrationDeleteOptions{Slug: "aws-prod"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Integration not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Delete integration without options",
+			options:      nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/v3/integrations/integration" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if got := r.URL.Query().Get("integration"); tt.options != nil && got != tt.options.Slug {
+					t.Errorf("Unexpected integration query parameter: %q", got)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.IntegrationDeleteResponse{
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &integration.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotResponse, err := client.Delete(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
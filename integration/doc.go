@@ -0,0 +1,18 @@
+/*
+Package integration provides a client for the Doppler API's integration endpoints.
+
+API-Docs: https://docs.doppler.com/reference/integrations-list
+
+Example:
+
+	// List all integrations and their syncs
+	integrations, _, err := integration.List(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, i := range integrations {
+		fmt.Printf("%s (%s): %d syncs\n", *i.Name, *i.Type, len(i.Syncs))
+	}
+*/
+package integration
@@ -0,0 +1,109 @@
+// Package mockapi mocks the Doppler API for tests that span several endpoints.
+package mockapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/pointer"
+)
+
+// Route returns the response to a request to its path.
+type Route func(r *http.Request) any
+
+// Server is a mock of the Doppler API serving a fixed set of routes, keyed by path. Requests to other paths fail the
+// test. The server is closed when the test finishes.
+type Server struct {
+	*httptest.Server
+
+	t      *testing.T
+	routes map[string]Route
+
+	mu       sync.Mutex
+	failPath string
+	requests map[string]int // By path.
+}
+
+// New starts a new mock server serving the given routes.
+func New(t *testing.T, routes map[string]Route) *Server {
+	s := &Server{t: t, routes: routes, requests: make(map[string]int)}
+	s.Server = httptest.NewServer(s)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Fail makes all requests to the given path fail with 500 Internal Server Error.
+func (s *Server) Fail(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failPath = path
+}
+
+// Requests returns the number of requests received for the given path.
+func (s *Server) Requests(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests[path]
+}
+
+// Backend returns a backend sending requests to the server.
+func (s *Server) Backend() doppler.Backend {
+	return doppler.GetBackendWithConfig(&doppler.BackendConfig{
+		URL: pointer.To(s.URL),
+	})
+}
+
+// ServeHTTP responds with the response of the request's route.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	s.mu.Lock()
+	s.requests[r.URL.Path]++
+	fail := r.URL.Path == s.failPath
+	s.mu.Unlock()
+
+	if fail {
+		w.WriteHeader(http.StatusInternalServerError)
+		_ = json.NewEncoder(w).Encode(&doppler.APIResponse{Success: pointer.To(false), Messages: []string{"Internal error"}})
+		return
+	}
+
+	route, ok := s.routes[r.URL.Path]
+	if !ok {
+		s.t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(route(r)); err != nil {
+		s.t.Errorf("Failed to write response: %v", err)
+	}
+}
+
+// OK returns the API response of a successful request.
+func OK() doppler.APIResponse {
+	return doppler.APIResponse{Success: pointer.To(true)}
+}
+
+// Page returns the page of the items requested by the page and per_page query parameters.
+func Page[T any](r *http.Request, items []T) []T {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	start, end := (page-1)*perPage, page*perPage
+	if start > len(items) {
+		start = len(items)
+	}
+	if end > len(items) {
+		end = len(items)
+	}
+
+	return items[start:end]
+}
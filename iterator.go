@@ -0,0 +1,97 @@
+package doppler
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultIteratorPerPage is the number of items an iterator fetches per request if no page size is given.
+const DefaultIteratorPerPage = 100
+
+// ErrIteratorDone is returned by an iterator's Next method once all items were returned.
+var ErrIteratorDone = errors.New("no more items in iterator")
+
+// Iterator iterates over a sequence of items. Next returns the next item, ErrIteratorDone once the sequence is
+// exhausted, or any other error if the next item couldn't be retrieved.
+type Iterator[T any] interface {
+	Next() (T, error)
+}
+
+// PageFetcher fetches a single page of a list endpoint.
+type PageFetcher[T any] func(ctx context.Context, opts ListOptions) ([]T, error)
+
+// pageIterator iterates over the items of a paginated list endpoint, fetching pages on demand.
+type pageIterator[T any] struct {
+	ctx   context.Context
+	fetch PageFetcher[T]
+	opts  ListOptions
+
+	items []T
+	last  bool
+	err   error
+}
+
+// NewPageIterator returns an iterator over the items of a paginated list endpoint. Pages are fetched on demand,
+// starting at opts.Page, until a page is shorter than opts.PerPage. A zero page starts at the first page, a zero page
+// size defaults to DefaultIteratorPerPage.
+func NewPageIterator[T any](ctx context.Context, opts ListOptions, fetch PageFetcher[T]) Iterator[T] {
+	if opts.Page < 1 {
+		opts.Page = 1
+	}
+	if opts.PerPage < 1 {
+		opts.PerPage = DefaultIteratorPerPage
+	}
+
+	return &pageIterator[T]{ctx: ctx, fetch: fetch, opts: opts}
+}
+
+// Next returns the next item, fetching the next page if necessary.
+func (it *pageIterator[T]) Next() (T, error) {
+	var zero T
+	for len(it.items) == 0 {
+		if it.err != nil {
+			return zero, it.err
+		}
+		if it.last {
+			return zero, ErrIteratorDone
+		}
+
+		items, err := it.fetch(it.ctx, it.opts)
+		if err != nil {
+			it.err = errors.Wrapf(err, "fetch page %d", it.opts.Page)
+			return zero, it.err
+		}
+		it.items = items
+		it.last = len(items) < it.opts.PerPage
+		it.opts.Page++
+	}
+
+	item := it.items[0]
+	it.items = it.items[1:]
+
+	return item, nil
+}
+
+// sliceIterator iterates over the items of a slice.
+type sliceIterator[T any] struct {
+	items []T
+}
+
+// NewSliceIterator returns an iterator over the given items.
+func NewSliceIterator[T any](items []T) Iterator[T] {
+	return &sliceIterator[T]{items: items}
+}
+
+// Next returns the next item of the slice.
+func (it *sliceIterator[T]) Next() (T, error) {
+	var zero T
+	if len(it.items) == 0 {
+		return zero, ErrIteratorDone
+	}
+
+	item := it.items[0]
+	it.items = it.items[1:]
+
+	return item, nil
+}
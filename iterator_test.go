@@ -0,0 +1,134 @@
+package doppler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+// drain collects the items of the iterator until it's exhausted or fails.
+func drain[T any](it Iterator[T]) ([]T, error) {
+	var items []T
+	for {
+		item, err := it.Next()
+		if errors.Is(err, ErrIteratorDone) {
+			return items, nil
+		}
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+	}
+}
+
+func TestPageIterator(t *testing.T) {
+	t.Parallel()
+
+	errFetch := errors.New("fetch failed")
+
+	tests := []struct {
+		name      string
+		opts      ListOptions
+		total     int
+		failPage  int
+		want      []int
+		wantPages []int
+		wantErr   error
+	}{
+		{
+			name:      "Multiple pages",
+			opts:      ListOptions{PerPage: 2},
+			total:     5,
+			want:      []int{0, 1, 2, 3, 4},
+			wantPages: []int{1, 2, 3},
+		},
+		{
+			name:      "Full last page",
+			opts:      ListOptions{PerPage: 2},
+			total:     4,
+			want:      []int{0, 1, 2, 3},
+			wantPages: []int{1, 2, 3},
+		},
+		{
+			name:      "Start page",
+			opts:      ListOptions{Page: 2, PerPage: 2},
+			total:     5,
+			want:      []int{2, 3, 4},
+			wantPages: []int{2, 3},
+		},
+		{
+			name:      "Default page size",
+			total:     3,
+			want:      []int{0, 1, 2},
+			wantPages: []int{1},
+		},
+		{
+			name:      "Empty",
+			opts:      ListOptions{PerPage: 2},
+			wantPages: []int{1},
+		},
+		{
+			name:      "Failing page",
+			opts:      ListOptions{PerPage: 2},
+			total:     5,
+			failPage:  2,
+			want:      []int{0, 1},
+			wantPages: []int{1, 2},
+			wantErr:   errFetch,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotPages []int
+			it := NewPageIterator(context.Background(), tt.opts, func(_ context.Context, opts ListOptions) ([]int, error) {
+				gotPages = append(gotPages, opts.Page)
+				if opts.Page == tt.failPage {
+					return nil, errFetch
+				}
+
+				var items []int
+				for i := (opts.Page - 1) * opts.PerPage; i < opts.Page*opts.PerPage && i < tt.total; i++ {
+					items = append(items, i)
+				}
+				return items, nil
+			})
+
+			got, err := drain(it)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Unexpected error. Expected %v, got %v", tt.wantErr, err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Unexpected items (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantPages, gotPages); diff != "" {
+				t.Errorf("Unexpected fetched pages (-want +got):\n%s", diff)
+			}
+
+			// An exhausted or failed iterator must not fetch again.
+			if _, err := it.Next(); err == nil {
+				t.Error("Expected exhausted iterator to keep failing")
+			}
+			if len(gotPages) != len(tt.wantPages) {
+				t.Errorf("Expected no further fetches, got pages %v", gotPages)
+			}
+		})
+	}
+}
+
+func TestSliceIterator(t *testing.T) {
+	t.Parallel()
+
+	got, err := drain(NewSliceIterator([]string{"a", "b"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"a", "b"}, got); diff != "" {
+		t.Errorf("Unexpected items (-want +got):\n%s", diff)
+	}
+}
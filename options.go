@@ -10,8 +10,8 @@ type (
 	// ListOptions is the base struct for all list options. It contains the common parameters used across all list
 	// endpoints. It's meant to be embedded in more specific list options structs.
 	ListOptions struct {
-		Page    int `url:"page,omitempty"`
-		PerPage int `url:"per_page,omitempty"`
+		Page    int `url:"page,omitempty" validate:"gte=0"`
+		PerPage int `url:"per_page,omitempty" validate:"gte=0"`
 	}
 
 	// parameters is a normalized way to represent query parameters. It's commonly used to represent endpoint options
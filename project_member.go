@@ -0,0 +1,118 @@
+package doppler
+
+const (
+	// MemberTypeWorkplaceUser is the member type of workplace users.
+	MemberTypeWorkplaceUser = "workplace_user"
+
+	// MemberTypeGroup is the member type of groups.
+	MemberTypeGroup = "group"
+
+	// MemberTypeInvite is the member type of pending workplace invites.
+	MemberTypeInvite = "invite"
+
+	// MemberTypeServiceAccount is the member type of service accounts.
+	MemberTypeServiceAccount = "service_account"
+)
+
+type (
+	// ProjectMemberRole represents the role of a project member.
+	ProjectMemberRole struct {
+		Identifier *string `json:"identifier,omitempty"` // Identifier of the project role, e.g. "admin" or "viewer".
+	}
+
+	// ProjectMember represents a member of a Doppler project.
+	ProjectMember struct {
+		Type                  *string            `json:"type,omitempty"`                    // Type of the member. One of workplace_user, group, invite, service_account.
+		Slug                  *string            `json:"slug,omitempty"`                    // Unique identifier of the member.
+		Role                  *ProjectMemberRole `json:"role,omitempty"`                    // Role of the member in the project.
+		AccessAllEnvironments *bool              `json:"access_all_environments,omitempty"` // Whether the member has access to all environments of the project.
+		Environments          []string           `json:"environments,omitempty"`            // Environment slugs the member has access to, if not all.
+	}
+
+	// ProjectMemberListResponse represents a response from the project member list endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/projects/project/members
+	// Docs:     https://docs.doppler.com/reference/project-members-list
+	ProjectMemberListResponse struct {
+		APIResponse `json:",inline"`
+		Members     []*ProjectMember `json:"members"`
+	}
+
+	// ProjectMemberListOptions represents the query parameters for a project member list request.
+	ProjectMemberListOptions struct {
+		ListOptions `url:",inline" json:"-"`
+		Project     string `url:"project" json:"-" validate:"required"` // Identifier of the project.
+	}
+
+	// ProjectMemberGetResponse represents a response from the project member get endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/projects/project/members/member/{type}/{slug}
+	// Docs:     https://docs.doppler.com/reference/project-members-retrieve
+	ProjectMemberGetResponse struct {
+		APIResponse `json:",inline"`
+		Member      *ProjectMember `json:"member,omitempty"`
+	}
+
+	// ProjectMemberGetOptions represents the options for the project member get endpoint.
+	ProjectMemberGetOptions struct {
+		Project string `url:"project" json:"-" validate:"required"`                                             // Identifier of the project.
+		Type    string `url:"-" json:"-" validate:"required,oneof=workplace_user group invite service_account"` // Type of the member.
+		Slug    string `url:"-" json:"-" validate:"required"`                                                   // Unique identifier of the member.
+	}
+
+	// ProjectMemberAddResponse represents a response from the project member add endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/projects/project/members
+	// Docs:     https://docs.doppler.com/reference/project-members-add
+	ProjectMemberAddResponse struct {
+		APIResponse `json:",inline"`
+		Member      *ProjectMember `json:"member,omitempty"`
+	}
+
+	// ProjectMemberAddOptions represents the options for the project member add endpoint.
+	ProjectMemberAddOptions struct {
+		Project      string   `url:"project" json:"-" validate:"required"`                                                // Identifier of the project.
+		Type         string   `url:"-" json:"type" validate:"required,oneof=workplace_user group invite service_account"` // Type of the member.
+		Slug         string   `url:"-" json:"slug" validate:"required"`                                                   // Unique identifier of the member.
+		Role         string   `url:"-" json:"role" validate:"required"`                                                   // Identifier of the project role.
+		Environments []string `url:"-" json:"environments,omitempty" validate:"omitempty,dive,required"`                  // Environment slugs to grant access to. Empty for all environments, if the role allows it.
+	}
+
+	// ProjectMemberUpdateResponse represents a response from the project member update endpoint.
+	//
+	// Method:   PATCH
+	// Endpoint: https://api.doppler.com/v3/projects/project/members/member/{type}/{slug}
+	// Docs:     https://docs.doppler.com/reference/project-members-update
+	ProjectMemberUpdateResponse struct {
+		APIResponse `json:",inline"`
+		Member      *ProjectMember `json:"member,omitempty"`
+	}
+
+	// ProjectMemberUpdateOptions represents the options for the project member update endpoint.
+	ProjectMemberUpdateOptions struct {
+		Project      string   `url:"project" json:"-" validate:"required"`                                                     // Identifier of the project.
+		Type         string   `url:"-" json:"-" validate:"required,oneof=workplace_user group invite service_account"`         // Type of the member.
+		Slug         string   `url:"-" json:"-" validate:"required"`                                                           // Unique identifier of the member.
+		Role         *string  `url:"-" json:"role,omitempty" validate:"required_without=Environments"`                         // Identifier of the new project role.
+		Environments []string `url:"-" json:"environments,omitempty" validate:"required_without=Role,omitempty,dive,required"` // New environment slugs to grant access to.
+	}
+
+	// ProjectMemberRemoveResponse represents a response from the project member remove endpoint.
+	//
+	// Method:   DELETE
+	// Endpoint: https://api.doppler.com/v3/projects/project/members/member/{type}/{slug}
+	// Docs:     https://docs.doppler.com/reference/project-members-delete
+	ProjectMemberRemoveResponse struct {
+		APIResponse `json:",inline"`
+	}
+
+	// ProjectMemberRemoveOptions represents the options for the project member remove endpoint.
+	ProjectMemberRemoveOptions struct {
+		Project string `url:"project" json:"-" validate:"required"`                                             // Identifier of the project.
+		Type    string `url:"-" json:"-" validate:"required,oneof=workplace_user group invite service_account"` // Type of the member.
+		Slug    string `url:"-" json:"-" validate:"required"`                                                   // Unique identifier of the member.
+	}
+)
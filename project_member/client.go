@@ -0,0 +1,152 @@
+package projectmember
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/nikoksr/doppler-go"
+)
+
+// Client is the client used to invoke /v3/projects/project/members APIs.
+type Client struct {
+	Backend doppler.Backend
+	Key     string
+}
+
+// Default returns a new client based on the SDK's default backend and API key.
+func Default() *Client {
+	return &Client{
+		Backend: doppler.GetBackend(),
+		Key:     doppler.Key,
+	}
+}
+
+// memberPath returns the path of the project member with the given type and slug.
+func memberPath(memberType, slug string) string {
+	return fmt.Sprintf("/v3/projects/project/members/member/%s/%s", url.PathEscape(memberType), url.PathEscape(slug))
+}
+
+func (c Client) list(ctx context.Context, opts *doppler.ProjectMemberListOptions) ([]*doppler.ProjectMember, doppler.APIResponse, error) {
+	var resp doppler.ProjectMemberListResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    "/v3/projects/project/members",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Members, resp.APIResponse, err
+}
+
+// List returns a list of members of a project.
+func (c Client) List(ctx context.Context, opts *doppler.ProjectMemberListOptions) ([]*doppler.ProjectMember, doppler.APIResponse, error) {
+	return c.list(ctx, opts)
+}
+
+// List returns a list of members of a project using the default client.
+func List(ctx context.Context, opts *doppler.ProjectMemberListOptions) ([]*doppler.ProjectMember, doppler.APIResponse, error) {
+	return Default().List(ctx, opts)
+}
+
+func (c Client) get(ctx context.Context, opts *doppler.ProjectMemberGetOptions) (*doppler.ProjectMember, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.ProjectMemberGetResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    memberPath(opts.Type, opts.Slug),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Member, resp.APIResponse, err
+}
+
+// Get returns a member of a project.
+func (c Client) Get(ctx context.Context, opts *doppler.ProjectMemberGetOptions) (*doppler.ProjectMember, doppler.APIResponse, error) {
+	return c.get(ctx, opts)
+}
+
+// Get returns a member of a project using the default client.
+func Get(ctx context.Context, opts *doppler.ProjectMemberGetOptions) (*doppler.ProjectMember, doppler.APIResponse, error) {
+	return Default().Get(ctx, opts)
+}
+
+func (c Client) add(ctx context.Context, opts *doppler.ProjectMemberAddOptions) (*doppler.ProjectMember, doppler.APIResponse, error) {
+	var resp doppler.ProjectMemberAddResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/projects/project/members",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Member, resp.APIResponse, err
+}
+
+// Add adds a workplace user, group, service account or invite to a project. The member gets access to the given
+// environments only, or to all environments if none are given.
+func (c Client) Add(ctx context.Context, opts *doppler.ProjectMemberAddOptions) (*doppler.ProjectMember, doppler.APIResponse, error) {
+	return c.add(ctx, opts)
+}
+
+// Add adds a workplace user, group, service account or invite to a project using the default client.
+func Add(ctx context.Context, opts *doppler.ProjectMemberAddOptions) (*doppler.ProjectMember, doppler.APIResponse, error) {
+	return Default().Add(ctx, opts)
+}
+
+func (c Client) update(ctx context.Context, opts *doppler.ProjectMemberUpdateOptions) (*doppler.ProjectMember, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.ProjectMemberUpdateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPatch,
+		Path:    memberPath(opts.Type, opts.Slug),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Member, resp.APIResponse, err
+}
+
+// Update updates the role and/or the environment access of a project member.
+func (c Client) Update(ctx context.Context, opts *doppler.ProjectMemberUpdateOptions) (*doppler.ProjectMember, doppler.APIResponse, error) {
+	return c.update(ctx, opts)
+}
+
+// Update updates the role and/or the environment access of a project member using the default client.
+func Update(ctx context.Context, opts *doppler.ProjectMemberUpdateOptions) (*doppler.ProjectMember, doppler.APIResponse, error) {
+	return Default().Update(ctx, opts)
+}
+
+func (c Client) remove(ctx context.Context, opts *doppler.ProjectMemberRemoveOptions) (doppler.APIResponse, error) {
+	if opts == nil {
+		return doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.ProjectMemberRemoveResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodDelete,
+		Path:    memberPath(opts.Type, opts.Slug),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.APIResponse, err
+}
+
+// Remove removes a member from a project.
+func (c Client) Remove(ctx context.Context, opts *doppler.ProjectMemberRemoveOptions) (doppler.APIResponse, error) {
+	return c.remove(ctx, opts)
+}
+
+// Remove removes a member from a project using the default client.
+func Remove(ctx context.Context, opts *doppler.ProjectMemberRemoveOptions) (doppler.APIResponse, error) {
+	return Default().Remove(ctx, opts)
+}
@@ -0,0 +1,638 @@
+package projectmember_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/pointer"
+	projectmember "github.com/nikoksr/doppler-go/project_member"
+)
+
+func TestDefault(t *testing.T) {
+	t.Parallel()
+
+	client := projectmember.Default()
+	if client == nil {
+		t.Fatal("Expected client to be set")
+	}
+	if client.Backend == nil {
+		t.Fatal("Expected client backend to be set")
+	}
+	if client.Key != doppler.Key {
+		t.Fatalf("Expected client key to be %q, got %q", doppler.Key, client.Key)
+	}
+}
+
+func TestProjectMember_List(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ProjectMemberListOptions
+		wantMembers  []*doppler.ProjectMember
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "List members",
+			options: &doppler.ProjectMemberListOptions{Project: "test"},
+			wantMembers: []*doppler.ProjectMember{
+				{
+					Type:                  pointer.To(doppler.MemberTypeWorkplaceUser),
+					Slug:                  pointer.To("user"),
+					Role:                  &doppler.ProjectMemberRole{Identifier: pointer.To("admin")},
+					AccessAllEnvironments: pointer.To(true),
+				},
+				{
+					Type:                  pointer.To(doppler.MemberTypeGroup),
+					Slug:                  pointer.To("group"),
+					Role:                  &doppler.ProjectMemberRole{Identifier: pointer.To("viewer")},
+					AccessAllEnvironments: pointer.To(false),
+					Environments:          []string{"dev", "stg"},
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:        "List members with error",
+			options:     &doppler.ProjectMemberListOptions{Project: "test"},
+			wantMembers: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"error"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "List members without project",
+			options:      &doppler.ProjectMemberListOptions{},
+			wantMembers:  nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/projects/project/members" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if got := r.URL.Query().Get("project"); got != tt.options.Project {
+					t.Errorf("Unexpected project query parameter: %q", got)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ProjectMemberListResponse{
+					Members:     tt.wantMembers,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &projectmember.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotMembers, gotResponse, err := client.List(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the members are expected.
+			if diff := cmp.Diff(tt.wantMembers, gotMembers); diff != "" {
+				t.Errorf("Unexpected members (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectMember_Get(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ProjectMemberGetOptions
+		wantPath     string
+		wantMember   *doppler.ProjectMember
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "Get member",
+			options: &doppler.ProjectMemberGetOptions{
+				Project: "test",
+				Type:    doppler.MemberTypeServiceAccount,
+				Slug:    "ci",
+			},
+			wantPath: "/v3/projects/project/members/member/service_account/ci",
+			wantMember: &doppler.ProjectMember{
+				Type:                  pointer.To(doppler.MemberTypeServiceAccount),
+				Slug:                  pointer.To("ci"),
+				Role:                  &doppler.ProjectMemberRole{Identifier: pointer.To("collaborator")},
+				AccessAllEnvironments: pointer.To(false),
+				Environments:          []string{"prd"},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Get member with error",
+			options: &doppler.ProjectMemberGetOptions{
+				Project: "test",
+				Type:    doppler.MemberTypeInvite,
+				Slug:    "unknown",
+			},
+			wantPath:   "/v3/projects/project/members/member/invite/unknown",
+			wantMember: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Member not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Get member with invalid type",
+			options: &doppler.ProjectMemberGetOptions{
+				Project: "test",
+				Type:    "team",
+				Slug:    "ci",
+			},
+			wantMember:   nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+		{
+			name:         "Get member without options",
+			options:      nil,
+			wantMember:   nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != tt.wantPath {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ProjectMemberGetResponse{
+					Member:      tt.wantMember,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &projectmember.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotMember, gotResponse, err := client.Get(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the member is expected.
+			if diff := cmp.Diff(tt.wantMember, gotMember); diff != "" {
+				t.Errorf("Unexpected member (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectMember_Add(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ProjectMemberAddOptions
+		wantBody     map[string]any
+		wantMember   *doppler.ProjectMember
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "Add member to all environments",
+			options: &doppler.ProjectMemberAddOptions{
+				Project: "test",
+				Type:    doppler.MemberTypeWorkplaceUser,
+				Slug:    "user",
+				Role:    "admin",
+			},
+			wantBody: map[string]any{"type": "workplace_user", "slug": "user", "role": "admin"},
+			wantMember: &doppler.ProjectMember{
+				Type:                  pointer.To(doppler.MemberTypeWorkplaceUser),
+				Slug:                  pointer.To("user"),
+				Role:                  &doppler.ProjectMemberRole{Identifier: pointer.To("admin")},
+				AccessAllEnvironments: pointer.To(true),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Add member to some environments",
+			options: &doppler.ProjectMemberAddOptions{
+				Project:      "test",
+				Type:         doppler.MemberTypeGroup,
+				Slug:         "group",
+				Role:         "viewer",
+				Environments: []string{"dev", "stg"},
+			},
+			wantBody: map[string]any{
+				"type":         "group",
+				"slug":         "group",
+				"role":         "viewer",
+				"environments": []any{"dev", "stg"},
+			},
+			wantMember: &doppler.ProjectMember{
+				Type:                  pointer.To(doppler.MemberTypeGroup),
+				Slug:                  pointer.To("group"),
+				Role:                  &doppler.ProjectMemberRole{Identifier: pointer.To("viewer")},
+				AccessAllEnvironments: pointer.To(false),
+				Environments:          []string{"dev", "stg"},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Add member with error",
+			options: &doppler.ProjectMemberAddOptions{
+				Project: "test",
+				Type:    doppler.MemberTypeWorkplaceUser,
+				Slug:    "user",
+				Role:    "unknown",
+			},
+			wantBody:   map[string]any{"type": "workplace_user", "slug": "user", "role": "unknown"},
+			wantMember: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"Invalid role"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Add member without role",
+			options: &doppler.ProjectMemberAddOptions{
+				Project: "test",
+				Type:    doppler.MemberTypeWorkplaceUser,
+				Slug:    "user",
+			},
+			wantMember:   nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/projects/project/members" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ProjectMemberAddResponse{
+					Member:      tt.wantMember,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &projectmember.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotMember, gotResponse, err := client.Add(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the member is expected.
+			if diff := cmp.Diff(tt.wantMember, gotMember); diff != "" {
+				t.Errorf("Unexpected member (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectMember_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ProjectMemberUpdateOptions
+		wantBody     map[string]any
+		wantMember   *doppler.ProjectMember
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "Update role",
+			options: &doppler.ProjectMemberUpdateOptions{
+				Project: "test",
+				Type:    doppler.MemberTypeWorkplaceUser,
+				Slug:    "user",
+				Role:    pointer.To("viewer"),
+			},
+			wantBody: map[string]any{"role": "viewer"},
+			wantMember: &doppler.ProjectMember{
+				Type:                  pointer.To(doppler.MemberTypeWorkplaceUser),
+				Slug:                  pointer.To("user"),
+				Role:                  &doppler.ProjectMemberRole{Identifier: pointer.To("viewer")},
+				AccessAllEnvironments: pointer.To(true),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Update environments",
+			options: &doppler.ProjectMemberUpdateOptions{
+				Project:      "test",
+				Type:         doppler.MemberTypeWorkplaceUser,
+				Slug:         "user",
+				Environments: []string{"dev"},
+			},
+			wantBody: map[string]any{"environments": []any{"dev"}},
+			wantMember: &doppler.ProjectMember{
+				Type:                  pointer.To(doppler.MemberTypeWorkplaceUser),
+				Slug:                  pointer.To("user"),
+				Role:                  &doppler.ProjectMemberRole{Identifier: pointer.To("viewer")},
+				AccessAllEnvironments: pointer.To(false),
+				Environments:          []string{"dev"},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Update without changes",
+			options: &doppler.ProjectMemberUpdateOptions{
+				Project: "test",
+				Type:    doppler.MemberTypeWorkplaceUser,
+				Slug:    "user",
+			},
+			wantMember:   nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+		{
+			name:         "Update without options",
+			options:      nil,
+			wantMember:   nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch || r.URL.Path != "/v3/projects/project/members/member/workplace_user/user" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ProjectMemberUpdateResponse{
+					Member:      tt.wantMember,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &projectmember.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotMember, gotResponse, err := client.Update(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the member is expected.
+			if diff := cmp.Diff(tt.wantMember, gotMember); diff != "" {
+				t.Errorf("Unexpected member (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectMember_Remove(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ProjectMemberRemoveOptions
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "Remove member",
+			options: &doppler.ProjectMemberRemoveOptions{
+				Project: "test",
+				Type:    doppler.MemberTypeGroup,
+				Slug:    "group",
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Remove member with error",
+			options: &doppler.ProjectMemberRemoveOptions{
+				Project: "test",
+				Type:    doppler.MemberTypeGroup,
+				Slug:    "group",
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Member not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Remove member without options",
+			options:      nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/v3/projects/project/members/member/group/group" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ProjectMemberRemoveResponse{
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &projectmember.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotResponse, err := client.Remove(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+/*
+Package projectmember provides a client for the Doppler API's project member endpoints.
+
+API-Docs: https://docs.doppler.com/reference/project-members-list
+
+Example:
+
+	// Grant a group read access to the staging environment of a project
+	member, _, err := projectmember.Add(context.Background(), &doppler.ProjectMemberAddOptions{
+		Project:      "my-project",
+		Type:         doppler.MemberTypeGroup,
+		Slug:         "engineering",
+		Role:         "viewer",
+		Environments: []string{"stg"},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(*member.Slug)
+*/
+package projectmember
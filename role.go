@@ -0,0 +1,202 @@
+package doppler
+
+// Permission is a permission that can be granted by a custom role. Workplace roles use the workplace permissions,
+// project roles the project permissions. The lists below aren't exhaustive; use the permission list endpoints of the
+// role package to fetch the permissions available to your workplace.
+type Permission string
+
+// Workplace permissions.
+const (
+	PermissionTeamManage            Permission = "team_manage"             // Manage workplace users, groups and invites.
+	PermissionBillingManage         Permission = "billing_manage"          // Manage billing and view invoices.
+	PermissionSettingsManage        Permission = "settings_manage"         // Manage workplace settings.
+	PermissionAuditLogsView         Permission = "audit_logs_view"         // View the workplace activity and audit logs.
+	PermissionProjectCreate         Permission = "project_create"          // Create new projects.
+	PermissionServiceAccountsManage Permission = "service_accounts_manage" // Manage service accounts and their API tokens.
+	PermissionIntegrationsManage    Permission = "integrations_manage"     // Manage workplace integrations.
+	PermissionWebhooksManage        Permission = "webhooks_manage"         // Manage webhooks.
+	PermissionCustomRolesManage     Permission = "custom_roles_manage"     // Manage custom workplace and project roles.
+)
+
+// Project permissions.
+const (
+	PermissionProjectSettingsManage   Permission = "enclave_project_settings"   // Manage project settings and delete the project.
+	PermissionProjectMembersManage    Permission = "enclave_project_members"    // Manage project members and their roles.
+	PermissionProjectEnvironments     Permission = "enclave_environments"       // Create, rename and delete environments.
+	PermissionProjectConfigs          Permission = "enclave_configs"            // Create, rename, lock and delete branch configs.
+	PermissionProjectConfigLogs       Permission = "enclave_config_logs"        // View config logs.
+	PermissionProjectConfigRollback   Permission = "enclave_config_pit"         // Roll back configs to previous versions.
+	PermissionProjectServiceTokens    Permission = "enclave_service_tokens"     // Manage service tokens.
+	PermissionProjectSecretsRead      Permission = "enclave_secrets_read"       // Read secret values.
+	PermissionProjectSecretsWrite     Permission = "enclave_secrets_write"      // Create, update and delete secrets.
+	PermissionProjectDynamicSecrets   Permission = "enclave_dynamic_secrets"    // Issue and revoke dynamic secret leases.
+	PermissionProjectIntegrations     Permission = "enclave_integrations"       // Manage integrations and syncs of the project.
+	PermissionProjectChangeRequests   Permission = "enclave_change_requests"    // Create and review change requests.
+	PermissionProjectTrustedIPsManage Permission = "enclave_config_trusted_ips" // Manage trusted IP ranges of configs.
+)
+
+type (
+	// Role represents a workplace or project role.
+	Role struct {
+		Identifier   *string      `json:"identifier,omitempty"`     // Unique identifier of the role.
+		Name         *string      `json:"name,omitempty"`           // Name of the role.
+		Permissions  []Permission `json:"permissions,omitempty"`    // Permissions granted by the role.
+		IsCustomRole *bool        `json:"is_custom_role,omitempty"` // Whether the role is a custom role. Built-in roles can't be changed.
+		CreatedAt    *string      `json:"created_at,omitempty"`     // Time the role was created.
+	}
+
+	// PermissionListResponse represents a response from the workplace and project permission list endpoints.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/workplace/permissions
+	// Endpoint: https://api.doppler.com/v3/projects/permissions
+	// Docs:     https://docs.doppler.com/reference/custom-roles-list-permissions
+	PermissionListResponse struct {
+		APIResponse `json:",inline"`
+		Permissions []Permission `json:"permissions"`
+	}
+
+	// WorkplaceRoleListResponse represents a response from the workplace role list endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/workplace/roles
+	// Docs:     https://docs.doppler.com/reference/workplace-roles-list
+	WorkplaceRoleListResponse struct {
+		APIResponse `json:",inline"`
+		Roles       []*Role `json:"roles"`
+	}
+
+	// WorkplaceRoleGetResponse represents a response from the workplace role get endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/workplace/roles/role/{role}
+	// Docs:     https://docs.doppler.com/reference/workplace-roles-retrieve
+	WorkplaceRoleGetResponse struct {
+		APIResponse `json:",inline"`
+		Role        *Role `json:"role,omitempty"`
+	}
+
+	// WorkplaceRoleGetOptions represents the options for the workplace role get endpoint.
+	WorkplaceRoleGetOptions struct {
+		Identifier string `url:"-" json:"-" validate:"required"` // Unique identifier of the role.
+	}
+
+	// WorkplaceRoleCreateResponse represents a response from the workplace role create endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/workplace/roles
+	// Docs:     https://docs.doppler.com/reference/workplace-roles-create
+	WorkplaceRoleCreateResponse struct {
+		APIResponse `json:",inline"`
+		Role        *Role `json:"role,omitempty"`
+	}
+
+	// WorkplaceRoleCreateOptions represents the body parameters for a workplace role create request.
+	WorkplaceRoleCreateOptions struct {
+		Name        string       `url:"-" json:"name" validate:"required"`                            // Name of the role.
+		Permissions []Permission `url:"-" json:"permissions" validate:"required,min=1,dive,required"` // Permissions granted by the role.
+	}
+
+	// WorkplaceRoleUpdateResponse represents a response from the workplace role update endpoint.
+	//
+	// Method:   PATCH
+	// Endpoint: https://api.doppler.com/v3/workplace/roles/role/{role}
+	// Docs:     https://docs.doppler.com/reference/workplace-roles-update
+	WorkplaceRoleUpdateResponse struct {
+		APIResponse `json:",inline"`
+		Role        *Role `json:"role,omitempty"`
+	}
+
+	// WorkplaceRoleUpdateOptions represents the options for the workplace role update endpoint.
+	WorkplaceRoleUpdateOptions struct {
+		Identifier     string       `url:"-" json:"-" validate:"required"`                                                             // Unique identifier of the role.
+		NewName        *string      `url:"-" json:"name,omitempty" validate:"required_without=NewPermissions,omitempty,min=1"`         // New name of the role.
+		NewPermissions []Permission `url:"-" json:"permissions,omitempty" validate:"required_without=NewName,omitempty,dive,required"` // New permissions of the role. Replaces the current permissions.
+	}
+
+	// WorkplaceRoleDeleteResponse represents a response from the workplace role delete endpoint.
+	//
+	// Method:   DELETE
+	// Endpoint: https://api.doppler.com/v3/workplace/roles/role/{role}
+	// Docs:     https://docs.doppler.com/reference/workplace-roles-delete
+	WorkplaceRoleDeleteResponse struct {
+		APIResponse `json:",inline"`
+	}
+
+	// WorkplaceRoleDeleteOptions represents the options for the workplace role delete endpoint.
+	WorkplaceRoleDeleteOptions struct {
+		Identifier string `url:"-" json:"-" validate:"required"` // Unique identifier of the role.
+	}
+
+	// ProjectRoleListResponse represents a response from the project role list endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/projects/roles
+	// Docs:     https://docs.doppler.com/reference/project-roles-list
+	ProjectRoleListResponse struct {
+		APIResponse `json:",inline"`
+		Roles       []*Role `json:"roles"`
+	}
+
+	// ProjectRoleGetResponse represents a response from the project role get endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/projects/roles/role/{role}
+	// Docs:     https://docs.doppler.com/reference/project-roles-retrieve
+	ProjectRoleGetResponse struct {
+		APIResponse `json:",inline"`
+		Role        *Role `json:"role,omitempty"`
+	}
+
+	// ProjectRoleGetOptions represents the options for the project role get endpoint.
+	ProjectRoleGetOptions struct {
+		Identifier string `url:"-" json:"-" validate:"required"` // Unique identifier of the role.
+	}
+
+	// ProjectRoleCreateResponse represents a response from the project role create endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/projects/roles
+	// Docs:     https://docs.doppler.com/reference/project-roles-create
+	ProjectRoleCreateResponse struct {
+		APIResponse `json:",inline"`
+		Role        *Role `json:"role,omitempty"`
+	}
+
+	// ProjectRoleCreateOptions represents the body parameters for a project role create request.
+	ProjectRoleCreateOptions struct {
+		Name        string       `url:"-" json:"name" validate:"required"`                            // Name of the role.
+		Permissions []Permission `url:"-" json:"permissions" validate:"required,min=1,dive,required"` // Permissions granted by the role.
+	}
+
+	// ProjectRoleUpdateResponse represents a response from the project role update endpoint.
+	//
+	// Method:   PATCH
+	// Endpoint: https://api.doppler.com/v3/projects/roles/role/{role}
+	// Docs:     https://docs.doppler.com/reference/project-roles-update
+	ProjectRoleUpdateResponse struct {
+		APIResponse `json:",inline"`
+		Role        *Role `json:"role,omitempty"`
+	}
+
+	// ProjectRoleUpdateOptions represents the options for the project role update endpoint.
+	ProjectRoleUpdateOptions struct {
+		Identifier     string       `url:"-" json:"-" validate:"required"`                                                             // Unique identifier of the role.
+		NewName        *string      `url:"-" json:"name,omitempty" validate:"required_without=NewPermissions,omitempty,min=1"`         // New name of the role.
+		NewPermissions []Permission `url:"-" json:"permissions,omitempty" validate:"required_without=NewName,omitempty,dive,required"` // New permissions of the role. Replaces the current permissions.
+	}
+
+	// ProjectRoleDeleteResponse represents a response from the project role delete endpoint.
+	//
+	// Method:   DELETE
+	// Endpoint: https://api.doppler.com/v3/projects/roles/role/{role}
+	// Docs:     https://docs.doppler.com/reference/project-roles-delete
+	ProjectRoleDeleteResponse struct {
+		APIResponse `json:",inline"`
+	}
+
+	// ProjectRoleDeleteOptions represents the options for the project role delete endpoint.
+	ProjectRoleDeleteOptions struct {
+		Identifier string `url:"-" json:"-" validate:"required"` // Unique identifier of the role.
+	}
+)
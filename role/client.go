@@ -0,0 +1,318 @@
+package role
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/nikoksr/doppler-go"
+)
+
+// Client is the client used to invoke /v3/workplace/roles and /v3/projects/roles APIs.
+type Client struct {
+	Backend doppler.Backend
+	Key     string
+}
+
+// Default returns a new client based on the SDK's default backend and API key.
+func Default() *Client {
+	return &Client{
+		Backend: doppler.GetBackend(),
+		Key:     doppler.Key,
+	}
+}
+
+// workplaceRolePath returns the path of the workplace role with the given identifier.
+func workplaceRolePath(identifier string) string {
+	return fmt.Sprintf("/v3/workplace/roles/role/%s", url.PathEscape(identifier))
+}
+
+func (c Client) workplaceList(ctx context.Context) ([]*doppler.Role, doppler.APIResponse, error) {
+	var resp doppler.WorkplaceRoleListResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method: http.MethodGet,
+		Path:   "/v3/workplace/roles",
+		Key:    c.Key,
+	}, &resp)
+
+	return resp.Roles, resp.APIResponse, err
+}
+
+// WorkplaceList returns a list of all workplace roles, including the built-in ones.
+func (c Client) WorkplaceList(ctx context.Context) ([]*doppler.Role, doppler.APIResponse, error) {
+	return c.workplaceList(ctx)
+}
+
+// WorkplaceList returns a list of all workplace roles, including the built-in ones, using the default client.
+func WorkplaceList(ctx context.Context) ([]*doppler.Role, doppler.APIResponse, error) {
+	return Default().WorkplaceList(ctx)
+}
+
+func (c Client) workplaceGet(ctx context.Context, opts *doppler.WorkplaceRoleGetOptions) (*doppler.Role, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.WorkplaceRoleGetResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    workplaceRolePath(opts.Identifier),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Role, resp.APIResponse, err
+}
+
+// WorkplaceGet returns a workplace role.
+func (c Client) WorkplaceGet(ctx context.Context, opts *doppler.WorkplaceRoleGetOptions) (*doppler.Role, doppler.APIResponse, error) {
+	return c.workplaceGet(ctx, opts)
+}
+
+// WorkplaceGet returns a workplace role using the default client.
+func WorkplaceGet(ctx context.Context, opts *doppler.WorkplaceRoleGetOptions) (*doppler.Role, doppler.APIResponse, error) {
+	return Default().WorkplaceGet(ctx, opts)
+}
+
+func (c Client) workplaceCreate(ctx context.Context, opts *doppler.WorkplaceRoleCreateOptions) (*doppler.Role, doppler.APIResponse, error) {
+	var resp doppler.WorkplaceRoleCreateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/workplace/roles",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Role, resp.APIResponse, err
+}
+
+// WorkplaceCreate creates a new custom workplace role.
+func (c Client) WorkplaceCreate(ctx context.Context, opts *doppler.WorkplaceRoleCreateOptions) (*doppler.Role, doppler.APIResponse, error) {
+	return c.workplaceCreate(ctx, opts)
+}
+
+// WorkplaceCreate creates a new custom workplace role using the default client.
+func WorkplaceCreate(ctx context.Context, opts *doppler.WorkplaceRoleCreateOptions) (*doppler.Role, doppler.APIResponse, error) {
+	return Default().WorkplaceCreate(ctx, opts)
+}
+
+func (c Client) workplaceUpdate(ctx context.Context, opts *doppler.WorkplaceRoleUpdateOptions) (*doppler.Role, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.WorkplaceRoleUpdateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPatch,
+		Path:    workplaceRolePath(opts.Identifier),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Role, resp.APIResponse, err
+}
+
+// WorkplaceUpdate updates the name and/or the permissions of a custom workplace role.
+func (c Client) WorkplaceUpdate(ctx context.Context, opts *doppler.WorkplaceRoleUpdateOptions) (*doppler.Role, doppler.APIResponse, error) {
+	return c.workplaceUpdate(ctx, opts)
+}
+
+// WorkplaceUpdate updates the name and/or the permissions of a custom workplace role using the default client.
+func WorkplaceUpdate(ctx context.Context, opts *doppler.WorkplaceRoleUpdateOptions) (*doppler.Role, doppler.APIResponse, error) {
+	return Default().WorkplaceUpdate(ctx, opts)
+}
+
+func (c Client) workplaceDelete(ctx context.Context, opts *doppler.WorkplaceRoleDeleteOptions) (doppler.APIResponse, error) {
+	if opts == nil {
+		return doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.WorkplaceRoleDeleteResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodDelete,
+		Path:    workplaceRolePath(opts.Identifier),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.APIResponse, err
+}
+
+// WorkplaceDelete deletes a custom workplace role.
+func (c Client) WorkplaceDelete(ctx context.Context, opts *doppler.WorkplaceRoleDeleteOptions) (doppler.APIResponse, error) {
+	return c.workplaceDelete(ctx, opts)
+}
+
+// WorkplaceDelete deletes a custom workplace role using the default client.
+func WorkplaceDelete(ctx context.Context, opts *doppler.WorkplaceRoleDeleteOptions) (doppler.APIResponse, error) {
+	return Default().WorkplaceDelete(ctx, opts)
+}
+
+func (c Client) workplacePermissions(ctx context.Context) ([]doppler.Permission, doppler.APIResponse, error) {
+	var resp doppler.PermissionListResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method: http.MethodGet,
+		Path:   "/v3/workplace/permissions",
+		Key:    c.Key,
+	}, &resp)
+
+	return resp.Permissions, resp.APIResponse, err
+}
+
+// WorkplacePermissions returns the catalogue of permissions that can be granted by workplace roles.
+func (c Client) WorkplacePermissions(ctx context.Context) ([]doppler.Permission, doppler.APIResponse, error) {
+	return c.workplacePermissions(ctx)
+}
+
+// WorkplacePermissions returns the catalogue of permissions that can be granted by workplace roles using the default client.
+func WorkplacePermissions(ctx context.Context) ([]doppler.Permission, doppler.APIResponse, error) {
+	return Default().WorkplacePermissions(ctx)
+}
+
+// projectRolePath returns the path of the project role with the given identifier.
+func projectRolePath(identifier string) string {
+	return fmt.Sprintf("/v3/projects/roles/role/%s", url.PathEscape(identifier))
+}
+
+func (c Client) projectList(ctx context.Context) ([]*doppler.Role, doppler.APIResponse, error) {
+	var resp doppler.ProjectRoleListResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method: http.MethodGet,
+		Path:   "/v3/projects/roles",
+		Key:    c.Key,
+	}, &resp)
+
+	return resp.Roles, resp.APIResponse, err
+}
+
+// ProjectList returns a list of all project roles, including the built-in ones.
+func (c Client) ProjectList(ctx context.Context) ([]*doppler.Role, doppler.APIResponse, error) {
+	return c.projectList(ctx)
+}
+
+// ProjectList returns a list of all project roles, including the built-in ones, using the default client.
+func ProjectList(ctx context.Context) ([]*doppler.Role, doppler.APIResponse, error) {
+	return Default().ProjectList(ctx)
+}
+
+func (c Client) projectGet(ctx context.Context, opts *doppler.ProjectRoleGetOptions) (*doppler.Role, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.ProjectRoleGetResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    projectRolePath(opts.Identifier),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Role, resp.APIResponse, err
+}
+
+// ProjectGet returns a project role.
+func (c Client) ProjectGet(ctx context.Context, opts *doppler.ProjectRoleGetOptions) (*doppler.Role, doppler.APIResponse, error) {
+	return c.projectGet(ctx, opts)
+}
+
+// ProjectGet returns a project role using the default client.
+func ProjectGet(ctx context.Context, opts *doppler.ProjectRoleGetOptions) (*doppler.Role, doppler.APIResponse, error) {
+	return Default().ProjectGet(ctx, opts)
+}
+
+func (c Client) projectCreate(ctx context.Context, opts *doppler.ProjectRoleCreateOptions) (*doppler.Role, doppler.APIResponse, error) {
+	var resp doppler.ProjectRoleCreateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/projects/roles",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Role, resp.APIResponse, err
+}
+
+// ProjectCreate creates a new custom project role.
+func (c Client) ProjectCreate(ctx context.Context, opts *doppler.ProjectRoleCreateOptions) (*doppler.Role, doppler.APIResponse, error) {
+	return c.projectCreate(ctx, opts)
+}
+
+// ProjectCreate creates a new custom project role using the default client.
+func ProjectCreate(ctx context.Context, opts *doppler.ProjectRoleCreateOptions) (*doppler.Role, doppler.APIResponse, error) {
+	return Default().ProjectCreate(ctx, opts)
+}
+
+func (c Client) projectUpdate(ctx context.Context, opts *doppler.ProjectRoleUpdateOptions) (*doppler.Role, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.ProjectRoleUpdateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPatch,
+		Path:    projectRolePath(opts.Identifier),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Role, resp.APIResponse, err
+}
+
+// ProjectUpdate updates the name and/or the permissions of a custom project role.
+func (c Client) ProjectUpdate(ctx context.Context, opts *doppler.ProjectRoleUpdateOptions) (*doppler.Role, doppler.APIResponse, error) {
+	return c.projectUpdate(ctx, opts)
+}
+
+// ProjectUpdate updates the name and/or the permissions of a custom project role using the default client.
+func ProjectUpdate(ctx context.Context, opts *doppler.ProjectRoleUpdateOptions) (*doppler.Role, doppler.APIResponse, error) {
+	return Default().ProjectUpdate(ctx, opts)
+}
+
+func (c Client) projectDelete(ctx context.Context, opts *doppler.ProjectRoleDeleteOptions) (doppler.APIResponse, error) {
+	if opts == nil {
+		return doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.ProjectRoleDeleteResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodDelete,
+		Path:    projectRolePath(opts.Identifier),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.APIResponse, err
+}
+
+// ProjectDelete deletes a custom project role.
+func (c Client) ProjectDelete(ctx context.Context, opts *doppler.ProjectRoleDeleteOptions) (doppler.APIResponse, error) {
+	return c.projectDelete(ctx, opts)
+}
+
+// ProjectDelete deletes a custom project role using the default client.
+func ProjectDelete(ctx context.Context, opts *doppler.ProjectRoleDeleteOptions) (doppler.APIResponse, error) {
+	return Default().ProjectDelete(ctx, opts)
+}
+
+func (c Client) projectPermissions(ctx context.Context) ([]doppler.Permission, doppler.APIResponse, error) {
+	var resp doppler.PermissionListResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method: http.MethodGet,
+		Path:   "/v3/projects/permissions",
+		Key:    c.Key,
+	}, &resp)
+
+	return resp.Permissions, resp.APIResponse, err
+}
+
+// ProjectPermissions returns the catalogue of permissions that can be granted by project roles.
+func (c Client) ProjectPermissions(ctx context.Context) ([]doppler.Permission, doppler.APIResponse, error) {
+	return c.projectPermissions(ctx)
+}
+
+// ProjectPermissions returns the catalogue of permissions that can be granted by project roles using the default client.
+func ProjectPermissions(ctx context.Context) ([]doppler.Permission, doppler.APIResponse, error) {
+	return Default().ProjectPermissions(ctx)
+}
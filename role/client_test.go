@@ -0,0 +1,1211 @@
+package role_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/pointer"
+	"github.com/nikoksr/doppler-go/role"
+)
+
+func TestDefault(t *testing.T) {
+	t.Parallel()
+
+	client := role.Default()
+	if client == nil {
+		t.Fatal("Expected client to be set")
+	}
+	if client.Backend == nil {
+		t.Fatal("Expected client backend to be set")
+	}
+	if client.Key != doppler.Key {
+		t.Fatalf("Expected client key to be %q, got %q", doppler.Key, client.Key)
+	}
+}
+
+func TestRole_WorkplaceList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		wantRoles    []*doppler.Role
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "List roles",
+			wantRoles: []*doppler.Role{
+				{
+					Identifier:   pointer.To("owner"),
+					Name:         pointer.To("Owner"),
+					IsCustomRole: pointer.To(false),
+				},
+				{
+					Identifier:   pointer.To("deployer"),
+					Name:         pointer.To("Deployer"),
+					Permissions:  []doppler.Permission{doppler.PermissionTeamManage, doppler.PermissionAuditLogsView},
+					IsCustomRole: pointer.To(true),
+					CreatedAt:    pointer.To("2021-01-01T00:00:00.000Z"),
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:      "List roles with error",
+			wantRoles: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "401 Unauthorized",
+				StatusCode: http.StatusUnauthorized,
+				Messages:   []string{"Invalid Auth token"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/workplace/roles" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.WorkplaceRoleListResponse{
+					Roles:       tt.wantRoles,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &role.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotRoles, gotResponse, err := client.WorkplaceList(context.Background())
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the roles are expected.
+			if diff := cmp.Diff(tt.wantRoles, gotRoles); diff != "" {
+				t.Errorf("Unexpected roles (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRole_WorkplaceGet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.WorkplaceRoleGetOptions
+		wantRole     *doppler.Role
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "Get role",
+			options: &doppler.WorkplaceRoleGetOptions{Identifier: "deployer"},
+			wantRole: &doppler.Role{
+				Identifier:   pointer.To("deployer"),
+				Name:         pointer.To("Deployer"),
+				Permissions:  []doppler.Permission{doppler.PermissionTeamManage, doppler.PermissionAuditLogsView},
+				IsCustomRole: pointer.To(true),
+				CreatedAt:    pointer.To("2021-01-01T00:00:00.000Z"),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Get role with error",
+			options:  &doppler.WorkplaceRoleGetOptions{Identifier: "deployer"},
+			wantRole: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Role not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Get role without options",
+			options:      nil,
+			wantRole:     nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/workplace/roles/role/deployer" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.WorkplaceRoleGetResponse{
+					Role:        tt.wantRole,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &role.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotRole, gotResponse, err := client.WorkplaceGet(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the role is expected.
+			if diff := cmp.Diff(tt.wantRole, gotRole); diff != "" {
+				t.Errorf("Unexpected role (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRole_WorkplaceCreate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.WorkplaceRoleCreateOptions
+		wantBody     map[string]any
+		wantRole     *doppler.Role
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "Create role",
+			options: &doppler.WorkplaceRoleCreateOptions{
+				Name:        "Deployer",
+				Permissions: []doppler.Permission{doppler.PermissionTeamManage, doppler.PermissionAuditLogsView},
+			},
+			wantBody: map[string]any{"name": "Deployer", "permissions": []any{"team_manage", "audit_logs_view"}},
+			wantRole: &doppler.Role{
+				Identifier:   pointer.To("deployer"),
+				Name:         pointer.To("Deployer"),
+				Permissions:  []doppler.Permission{doppler.PermissionTeamManage, doppler.PermissionAuditLogsView},
+				IsCustomRole: pointer.To(true),
+				CreatedAt:    pointer.To("2021-01-01T00:00:00.000Z"),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create role with error",
+			options: &doppler.WorkplaceRoleCreateOptions{
+				Name:        "Deployer",
+				Permissions: []doppler.Permission{"unknown"},
+			},
+			wantBody: map[string]any{"name": "Deployer", "permissions": []any{"unknown"}},
+			wantRole: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"Invalid permission"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Create role without permissions",
+			options:      &doppler.WorkplaceRoleCreateOptions{Name: "Deployer"},
+			wantRole:     nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/workplace/roles" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.WorkplaceRoleCreateResponse{
+					Role:        tt.wantRole,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &role.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotRole, gotResponse, err := client.WorkplaceCreate(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the role is expected.
+			if diff := cmp.Diff(tt.wantRole, gotRole); diff != "" {
+				t.Errorf("Unexpected role (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRole_WorkplaceUpdate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.WorkplaceRoleUpdateOptions
+		wantBody     map[string]any
+		wantRole     *doppler.Role
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "Update permissions",
+			options: &doppler.WorkplaceRoleUpdateOptions{
+				Identifier:     "deployer",
+				NewPermissions: []doppler.Permission{doppler.PermissionTeamManage, doppler.PermissionAuditLogsView},
+			},
+			wantBody: map[string]any{"permissions": []any{"team_manage", "audit_logs_view"}},
+			wantRole: &doppler.Role{
+				Identifier:   pointer.To("deployer"),
+				Name:         pointer.To("Deployer"),
+				Permissions:  []doppler.Permission{doppler.PermissionTeamManage, doppler.PermissionAuditLogsView},
+				IsCustomRole: pointer.To(true),
+				CreatedAt:    pointer.To("2021-01-01T00:00:00.000Z"),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Update name with error",
+			options: &doppler.WorkplaceRoleUpdateOptions{
+				Identifier: "owner",
+				NewName:    pointer.To("Boss"),
+			},
+			wantBody: map[string]any{"name": "Boss"},
+			wantRole: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"Built-in roles can't be changed"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Update without changes",
+			options:      &doppler.WorkplaceRoleUpdateOptions{Identifier: "deployer"},
+			wantRole:     nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch || r.URL.Path != "/v3/workplace/roles/role/"+tt.options.Identifier {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.WorkplaceRoleUpdateResponse{
+					Role:        tt.wantRole,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &role.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotRole, gotResponse, err := client.WorkplaceUpdate(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the role is expected.
+			if diff := cmp.Diff(tt.wantRole, gotRole); diff != "" {
+				t.Errorf("Unexpected role (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRole_WorkplaceDelete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.WorkplaceRoleDeleteOptions
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "Delete role",
+			options: &doppler.WorkplaceRoleDeleteOptions{Identifier: "deployer"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Delete role with error",
+			options: &doppler.WorkplaceRoleDeleteOptions{Identifier: "deployer"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"Role is still in use"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Delete role without options",
+			options:      nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/v3/workplace/roles/role/deployer" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.WorkplaceRoleDeleteResponse{
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &role.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotResponse, err := client.WorkplaceDelete(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRole_WorkplacePermissions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		wantPermissions []doppler.Permission
+		wantResponse    doppler.APIResponse
+		wantErr         bool
+	}{
+		{
+			name:            "List permissions",
+			wantPermissions: []doppler.Permission{doppler.PermissionTeamManage, doppler.PermissionAuditLogsView},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:            "List permissions with error",
+			wantPermissions: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "401 Unauthorized",
+				StatusCode: http.StatusUnauthorized,
+				Messages:   []string{"Invalid Auth token"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/workplace/permissions" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.PermissionListResponse{
+					Permissions: tt.wantPermissions,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &role.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotPermissions, gotResponse, err := client.WorkplacePermissions(context.Background())
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the permissions are expected.
+			if diff := cmp.Diff(tt.wantPermissions, gotPermissions); diff != "" {
+				t.Errorf("Unexpected permissions (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRole_ProjectList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		wantRoles    []*doppler.Role
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "List roles",
+			wantRoles: []*doppler.Role{
+				{
+					Identifier:   pointer.To("owner"),
+					Name:         pointer.To("Owner"),
+					IsCustomRole: pointer.To(false),
+				},
+				{
+					Identifier:   pointer.To("deployer"),
+					Name:         pointer.To("Deployer"),
+					Permissions:  []doppler.Permission{doppler.PermissionProjectSecretsRead, doppler.PermissionProjectConfigLogs},
+					IsCustomRole: pointer.To(true),
+					CreatedAt:    pointer.To("2021-01-01T00:00:00.000Z"),
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:      "List roles with error",
+			wantRoles: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "401 Unauthorized",
+				StatusCode: http.StatusUnauthorized,
+				Messages:   []string{"Invalid Auth token"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/projects/roles" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ProjectRoleListResponse{
+					Roles:       tt.wantRoles,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &role.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotRoles, gotResponse, err := client.ProjectList(context.Background())
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the roles are expected.
+			if diff := cmp.Diff(tt.wantRoles, gotRoles); diff != "" {
+				t.Errorf("Unexpected roles (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRole_ProjectGet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ProjectRoleGetOptions
+		wantRole     *doppler.Role
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "Get role",
+			options: &doppler.ProjectRoleGetOptions{Identifier: "deployer"},
+			wantRole: &doppler.Role{
+				Identifier:   pointer.To("deployer"),
+				Name:         pointer.To("Deployer"),
+				Permissions:  []doppler.Permission{doppler.PermissionProjectSecretsRead, doppler.PermissionProjectConfigLogs},
+				IsCustomRole: pointer.To(true),
+				CreatedAt:    pointer.To("2021-01-01T00:00:00.000Z"),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Get role with error",
+			options:  &doppler.ProjectRoleGetOptions{Identifier: "deployer"},
+			wantRole: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Role not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Get role without options",
+			options:      nil,
+			wantRole:     nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/projects/roles/role/deployer" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ProjectRoleGetResponse{
+					Role:        tt.wantRole,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &role.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotRole, gotResponse, err := client.ProjectGet(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the role is expected.
+			if diff := cmp.Diff(tt.wantRole, gotRole); diff != "" {
+				t.Errorf("Unexpected role (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRole_ProjectCreate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ProjectRoleCreateOptions
+		wantBody     map[string]any
+		wantRole     *doppler.Role
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "Create role",
+			options: &doppler.ProjectRoleCreateOptions{
+				Name:        "Deployer",
+				Permissions: []doppler.Permission{doppler.PermissionProjectSecretsRead, doppler.PermissionProjectConfigLogs},
+			},
+			wantBody: map[string]any{"name": "Deployer", "permissions": []any{"enclave_secrets_read", "enclave_config_logs"}},
+			wantRole: &doppler.Role{
+				Identifier:   pointer.To("deployer"),
+				Name:         pointer.To("Deployer"),
+				Permissions:  []doppler.Permission{doppler.PermissionProjectSecretsRead, doppler.PermissionProjectConfigLogs},
+				IsCustomRole: pointer.To(true),
+				CreatedAt:    pointer.To("2021-01-01T00:00:00.000Z"),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create role with error",
+			options: &doppler.ProjectRoleCreateOptions{
+				Name:        "Deployer",
+				Permissions: []doppler.Permission{"unknown"},
+			},
+			wantBody: map[string]any{"name": "Deployer", "permissions": []any{"unknown"}},
+			wantRole: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"Invalid permission"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Create role without permissions",
+			options:      &doppler.ProjectRoleCreateOptions{Name: "Deployer"},
+			wantRole:     nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/projects/roles" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ProjectRoleCreateResponse{
+					Role:        tt.wantRole,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &role.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotRole, gotResponse, err := client.ProjectCreate(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the role is expected.
+			if diff := cmp.Diff(tt.wantRole, gotRole); diff != "" {
+				t.Errorf("Unexpected role (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRole_ProjectUpdate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ProjectRoleUpdateOptions
+		wantBody     map[string]any
+		wantRole     *doppler.Role
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "Update permissions",
+			options: &doppler.ProjectRoleUpdateOptions{
+				Identifier:     "deployer",
+				NewPermissions: []doppler.Permission{doppler.PermissionProjectSecretsRead, doppler.PermissionProjectConfigLogs},
+			},
+			wantBody: map[string]any{"permissions": []any{"enclave_secrets_read", "enclave_config_logs"}},
+			wantRole: &doppler.Role{
+				Identifier:   pointer.To("deployer"),
+				Name:         pointer.To("Deployer"),
+				Permissions:  []doppler.Permission{doppler.PermissionProjectSecretsRead, doppler.PermissionProjectConfigLogs},
+				IsCustomRole: pointer.To(true),
+				CreatedAt:    pointer.To("2021-01-01T00:00:00.000Z"),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Update name with error",
+			options: &doppler.ProjectRoleUpdateOptions{
+				Identifier: "owner",
+				NewName:    pointer.To("Boss"),
+			},
+			wantBody: map[string]any{"name": "Boss"},
+			wantRole: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"Built-in roles can't be changed"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Update without changes",
+			options:      &doppler.ProjectRoleUpdateOptions{Identifier: "deployer"},
+			wantRole:     nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch || r.URL.Path != "/v3/projects/roles/role/"+tt.options.Identifier {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ProjectRoleUpdateResponse{
+					Role:        tt.wantRole,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &role.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotRole, gotResponse, err := client.ProjectUpdate(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the role is expected.
+			if diff := cmp.Diff(tt.wantRole, gotRole); diff != "" {
+				t.Errorf("Unexpected role (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRole_ProjectDelete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ProjectRoleDeleteOptions
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "Delete role",
+			options: &doppler.ProjectRoleDeleteOptions{Identifier: "deployer"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Delete role with error",
+			options: &doppler.ProjectRoleDeleteOptions{Identifier: "deployer"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"Role is still in use"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Delete role without options",
+			options:      nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/v3/projects/roles/role/deployer" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ProjectRoleDeleteResponse{
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &role.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotResponse, err := client.ProjectDelete(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRole_ProjectPermissions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		wantPermissions []doppler.Permission
+		wantResponse    doppler.APIResponse
+		wantErr         bool
+	}{
+		{
+			name:            "List permissions",
+			wantPermissions: []doppler.Permission{doppler.PermissionProjectSecretsRead, doppler.PermissionProjectConfigLogs},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:            "List permissions with error",
+			wantPermissions: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "401 Unauthorized",
+				StatusCode: http.StatusUnauthorized,
+				Messages:   []string{"Invalid Auth token"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/projects/permissions" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.PermissionListResponse{
+					Permissions: tt.wantPermissions,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &role.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotPermissions, gotResponse, err := client.ProjectPermissions(context.Background())
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the permissions are expected.
+			if diff := cmp.Diff(tt.wantPermissions, gotPermissions); diff != "" {
+				t.Errorf("Unexpected permissions (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
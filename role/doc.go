@@ -0,0 +1,22 @@
+/*
+Package role provides a client for the Doppler API's workplace and project role endpoints.
+
+API-Docs: https://docs.doppler.com/reference/project-roles-list
+
+Example:
+
+	// Create a least-privilege project role for deployments
+	r, _, err := role.ProjectCreate(context.Background(), &doppler.ProjectRoleCreateOptions{
+		Name: "Deployer",
+		Permissions: []doppler.Permission{
+			doppler.PermissionProjectSecretsRead,
+			doppler.PermissionProjectConfigLogs,
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(*r.Identifier)
+*/
+package role
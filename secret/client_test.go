@@ -182,14 +182,9 @@ func TestSecret_List(t *testing.T) {
 				IncludeDynamic:    pointer.To(true),
 				DynamicTTLSeconds: pointer.To[int32](-1),
 			},
-			wantSecrets: nil,
-			wantResponse: doppler.APIResponse{
-				Success:    pointer.To(false),
-				Status:     "400 Bad Request",
-				StatusCode: http.StatusBadRequest,
-				Messages:   []string{"dynamic_ttl_seconds must be greater than or equal to 0"},
-			},
-			wantErr: true,
+			wantSecrets:  nil,
+			wantResponse: doppler.APIResponse{}, // Rejected by the options validation; never sent.
+			wantErr:      true,
 		},
 		{
 			name: "List secrets with options validation error",
@@ -295,14 +290,9 @@ func TestSecret_Update(t *testing.T) {
 					"unknown":     "test_value_2",
 				},
 			},
-			wantSecrets: nil,
-			wantResponse: doppler.APIResponse{
-				Success:    pointer.To(false),
-				Status:     "400 Bad Request",
-				StatusCode: http.StatusBadRequest,
-				Messages:   []string{"dynamic_ttl_seconds must be greater than or equal to 0"},
-			},
-			wantErr: true,
+			wantSecrets:  nil,
+			wantResponse: doppler.APIResponse{}, // Rejected by the options validation; never sent.
+			wantErr:      true,
 		},
 		{
 			name: "Update secret validation error",
@@ -0,0 +1,153 @@
+package doppler
+
+type (
+	// ServiceAccountWorkplaceRole represents the workplace role of a service account. Either a predefined or custom role
+	// is referenced by its identifier, or an inline role is built from the given permissions.
+	ServiceAccountWorkplaceRole struct {
+		Identifier  *string      `json:"identifier,omitempty" validate:"required_without=Permissions,excluded_with=Permissions"` // Identifier of the workplace role.
+		Permissions []Permission `json:"permissions,omitempty" validate:"omitempty,dive,required"`                               // Permissions of an inline workplace role.
+	}
+
+	// ServiceAccount represents a Doppler service account.
+	ServiceAccount struct {
+		Name          *string                      `json:"name,omitempty"`           // Name of the service account.
+		Slug          *string                      `json:"slug,omitempty"`           // A unique identifier of the service account.
+		WorkplaceRole *ServiceAccountWorkplaceRole `json:"workplace_role,omitempty"` // The workplace role of the service account.
+		CreatedAt     *string                      `json:"created_at,omitempty"`     // Date and time of the object's creation.
+	}
+
+	// ServiceAccountToken represents an API token of a Doppler service account.
+	ServiceAccountToken struct {
+		Name       *string `json:"name,omitempty"`         // Name of the API token.
+		Slug       *string `json:"slug,omitempty"`         // A unique identifier of the API token.
+		Key        *string `json:"api_key,omitempty"`      // An API key with a "dp.sa." prefix that is used for authentication. Only available when creating the token.
+		ExpiresAt  *string `json:"expires_at,omitempty"`   // Date and time of the token's expiration, or null if token does not auto-expire.
+		LastSeenAt *string `json:"last_seen_at,omitempty"` // Date and time the token was last used.
+		CreatedAt  *string `json:"created_at,omitempty"`   // Date and time of the object's creation.
+	}
+
+	// ServiceAccountListResponse represents a response from the service-account list endpoint.
+	//
+	// Method: GET
+	// Endpoint: https://api.doppler.com/v3/workplace/service_accounts
+	// Docs:     https://docs.doppler.com/reference/service-accounts-list
+	ServiceAccountListResponse struct {
+		APIResponse     `json:",inline"`
+		ServiceAccounts []*ServiceAccount `json:"service_accounts"`
+	}
+
+	// ServiceAccountListOptions represents the options for the service-account list endpoint.
+	ServiceAccountListOptions struct {
+		ListOptions `url:",inline" json:"-"`
+	}
+
+	// ServiceAccountGetResponse represents a response from the service-account get endpoint.
+	//
+	// Method: GET
+	// Endpoint: https://api.doppler.com/v3/workplace/service_accounts/service_account/{slug}
+	// Docs:     https://docs.doppler.com/reference/service-accounts-get
+	ServiceAccountGetResponse struct {
+		APIResponse    `json:",inline"`
+		ServiceAccount *ServiceAccount `json:"service_account,omitempty"`
+	}
+
+	// ServiceAccountGetOptions represents the options for the service-account get endpoint.
+	ServiceAccountGetOptions struct {
+		Slug string `url:"-" json:"-" validate:"required"` // A unique identifier of the service account.
+	}
+
+	// ServiceAccountCreateResponse represents a response from the service-account create endpoint.
+	//
+	// Method: POST
+	// Endpoint: https://api.doppler.com/v3/workplace/service_accounts
+	// Docs:     https://docs.doppler.com/reference/service-accounts-create
+	ServiceAccountCreateResponse struct {
+		APIResponse    `json:",inline"`
+		ServiceAccount *ServiceAccount `json:"service_account,omitempty"`
+	}
+
+	// ServiceAccountCreateOptions represents the options for the service-account create endpoint.
+	ServiceAccountCreateOptions struct {
+		Name          string                       `url:"-" json:"name" validate:"required"`                      // Name of the service account.
+		WorkplaceRole *ServiceAccountWorkplaceRole `url:"-" json:"workplace_role,omitempty" validate:"omitempty"` // The workplace role of the service account. Defaults to the workplace's default role.
+	}
+
+	// ServiceAccountUpdateResponse represents a response from the service-account update endpoint.
+	//
+	// Method: PATCH
+	// Endpoint: https://api.doppler.com/v3/workplace/service_accounts/service_account/{slug}
+	// Docs:     https://docs.doppler.com/reference/service-accounts-update
+	ServiceAccountUpdateResponse struct {
+		APIResponse    `json:",inline"`
+		ServiceAccount *ServiceAccount `json:"service_account,omitempty"`
+	}
+
+	// ServiceAccountUpdateOptions represents the options for the service-account update endpoint.
+	ServiceAccountUpdateOptions struct {
+		Slug             string                       `url:"-" json:"-" validate:"required"`                                                       // A unique identifier of the service account.
+		NewName          *string                      `url:"-" json:"name,omitempty" validate:"required_without=NewWorkplaceRole,omitempty,min=1"` // New name of the service account.
+		NewWorkplaceRole *ServiceAccountWorkplaceRole `url:"-" json:"workplace_role,omitempty" validate:"required_without=NewName"`                // New workplace role of the service account.
+	}
+
+	// ServiceAccountDeleteResponse represents a response from the service-account delete endpoint.
+	//
+	// Method: DELETE
+	// Endpoint: https://api.doppler.com/v3/workplace/service_accounts/service_account/{slug}
+	// Docs:     https://docs.doppler.com/reference/service-accounts-delete
+	ServiceAccountDeleteResponse struct {
+		APIResponse `json:",inline"`
+	}
+
+	// ServiceAccountDeleteOptions represents the options for the service-account delete endpoint.
+	ServiceAccountDeleteOptions struct {
+		Slug string `url:"-" json:"-" validate:"required"` // A unique identifier of the service account.
+	}
+
+	// ServiceAccountTokenListResponse represents a response from the service-account token list endpoint.
+	//
+	// Method: GET
+	// Endpoint: https://api.doppler.com/v3/workplace/service_accounts/service_account/{slug}/tokens
+	// Docs:     https://docs.doppler.com/reference/service-account-api-tokens-list
+	ServiceAccountTokenListResponse struct {
+		APIResponse `json:",inline"`
+		Tokens      []*ServiceAccountToken `json:"api_tokens"`
+	}
+
+	// ServiceAccountTokenListOptions represents the options for the service-account token list endpoint.
+	ServiceAccountTokenListOptions struct {
+		ListOptions    `url:",inline" json:"-"`
+		ServiceAccount string `url:"-" json:"-" validate:"required"` // A unique identifier of the service account.
+	}
+
+	// ServiceAccountTokenCreateResponse represents a response from the service-account token create endpoint.
+	//
+	// Method: POST
+	// Endpoint: https://api.doppler.com/v3/workplace/service_accounts/service_account/{slug}/tokens
+	// Docs:     https://docs.doppler.com/reference/service-account-api-tokens-create
+	ServiceAccountTokenCreateResponse struct {
+		APIResponse `json:",inline"`
+		Token       *ServiceAccountToken `json:"api_token,omitempty"`
+	}
+
+	// ServiceAccountTokenCreateOptions represents the options for the service-account token create endpoint.
+	ServiceAccountTokenCreateOptions struct {
+		ServiceAccount string  `url:"-" json:"-" validate:"required"`    // A unique identifier of the service account.
+		Name           string  `url:"-" json:"name" validate:"required"` // Name of the API token.
+		ExpiresAt      *string `url:"-" json:"expires_at,omitempty"`     // Date and time of the token's expiration, or null if token does not auto-expire.
+	}
+
+	// ServiceAccountTokenDeleteResponse represents a response from the service-account token delete endpoint.
+	//
+	// Method: DELETE
+	// Endpoint: https://api.doppler.com/v3/workplace/service_accounts/service_account/{slug}/tokens/token/{token}
+	// Docs:     https://docs.doppler.com/reference/service-account-api-tokens-delete
+	ServiceAccountTokenDeleteResponse struct {
+		APIResponse `json:",inline"`
+	}
+
+	// ServiceAccountTokenDeleteOptions represents the options for the service-account token delete endpoint.
+	ServiceAccountTokenDeleteOptions struct {
+		ServiceAccount string `url:"-" json:"-" validate:"required"` // A unique identifier of the service account.
+		Slug           string `url:"-" json:"-" validate:"required"` // A unique identifier of the API token.
+	}
+)
@@ -0,0 +1,229 @@
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/nikoksr/doppler-go"
+)
+
+// Client is the client used to invoke /v3/workplace/service_accounts APIs.
+type Client struct {
+	Backend doppler.Backend
+	Key     string
+}
+
+// Default returns a new client based on the SDK's default backend and API key.
+func Default() *Client {
+	return &Client{
+		Backend: doppler.GetBackend(),
+		Key:     doppler.Key,
+	}
+}
+
+// serviceAccountPath returns the path of the service account with the given slug.
+func serviceAccountPath(slug string) string {
+	return fmt.Sprintf("/v3/workplace/service_accounts/service_account/%s", url.PathEscape(slug))
+}
+
+func (c Client) list(ctx context.Context, opts *doppler.ServiceAccountListOptions) ([]*doppler.ServiceAccount, doppler.APIResponse, error) {
+	var resp doppler.ServiceAccountListResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    "/v3/workplace/service_accounts",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.ServiceAccounts, resp.APIResponse, err
+}
+
+// List returns a list of service accounts.
+func (c Client) List(ctx context.Context, opts *doppler.ServiceAccountListOptions) ([]*doppler.ServiceAccount, doppler.APIResponse, error) {
+	return c.list(ctx, opts)
+}
+
+// List returns a list of service accounts using the default client.
+func List(ctx context.Context, opts *doppler.ServiceAccountListOptions) ([]*doppler.ServiceAccount, doppler.APIResponse, error) {
+	return Default().List(ctx, opts)
+}
+
+func (c Client) get(ctx context.Context, opts *doppler.ServiceAccountGetOptions) (*doppler.ServiceAccount, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.ServiceAccountGetResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    serviceAccountPath(opts.Slug),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.ServiceAccount, resp.APIResponse, err
+}
+
+// Get returns a service account.
+func (c Client) Get(ctx context.Context, opts *doppler.ServiceAccountGetOptions) (*doppler.ServiceAccount, doppler.APIResponse, error) {
+	return c.get(ctx, opts)
+}
+
+// Get returns a service account using the default client.
+func Get(ctx context.Context, opts *doppler.ServiceAccountGetOptions) (*doppler.ServiceAccount, doppler.APIResponse, error) {
+	return Default().Get(ctx, opts)
+}
+
+func (c Client) create(ctx context.Context, opts *doppler.ServiceAccountCreateOptions) (*doppler.ServiceAccount, doppler.APIResponse, error) {
+	var resp doppler.ServiceAccountCreateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/workplace/service_accounts",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.ServiceAccount, resp.APIResponse, err
+}
+
+// Create creates a new service account.
+func (c Client) Create(ctx context.Context, opts *doppler.ServiceAccountCreateOptions) (*doppler.ServiceAccount, doppler.APIResponse, error) {
+	return c.create(ctx, opts)
+}
+
+// Create creates a new service account using the default client.
+func Create(ctx context.Context, opts *doppler.ServiceAccountCreateOptions) (*doppler.ServiceAccount, doppler.APIResponse, error) {
+	return Default().Create(ctx, opts)
+}
+
+func (c Client) update(ctx context.Context, opts *doppler.ServiceAccountUpdateOptions) (*doppler.ServiceAccount, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.ServiceAccountUpdateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPatch,
+		Path:    serviceAccountPath(opts.Slug),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.ServiceAccount, resp.APIResponse, err
+}
+
+// Update updates the name and/or the workplace role of a service account.
+func (c Client) Update(ctx context.Context, opts *doppler.ServiceAccountUpdateOptions) (*doppler.ServiceAccount, doppler.APIResponse, error) {
+	return c.update(ctx, opts)
+}
+
+// Update updates the name and/or the workplace role of a service account using the default client.
+func Update(ctx context.Context, opts *doppler.ServiceAccountUpdateOptions) (*doppler.ServiceAccount, doppler.APIResponse, error) {
+	return Default().Update(ctx, opts)
+}
+
+func (c Client) delete(ctx context.Context, opts *doppler.ServiceAccountDeleteOptions) (doppler.APIResponse, error) {
+	if opts == nil {
+		return doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.ServiceAccountDeleteResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodDelete,
+		Path:    serviceAccountPath(opts.Slug),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.APIResponse, err
+}
+
+// Delete deletes a service account and revokes all of its API tokens.
+func (c Client) Delete(ctx context.Context, opts *doppler.ServiceAccountDeleteOptions) (doppler.APIResponse, error) {
+	return c.delete(ctx, opts)
+}
+
+// Delete deletes a service account and revokes all of its API tokens using the default client.
+func Delete(ctx context.Context, opts *doppler.ServiceAccountDeleteOptions) (doppler.APIResponse, error) {
+	return Default().Delete(ctx, opts)
+}
+
+func (c Client) tokenList(ctx context.Context, opts *doppler.ServiceAccountTokenListOptions) ([]*doppler.ServiceAccountToken, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.ServiceAccountTokenListResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    serviceAccountPath(opts.ServiceAccount) + "/tokens",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Tokens, resp.APIResponse, err
+}
+
+// TokenList returns a list of API tokens of a service account.
+func (c Client) TokenList(ctx context.Context, opts *doppler.ServiceAccountTokenListOptions) ([]*doppler.ServiceAccountToken, doppler.APIResponse, error) {
+	return c.tokenList(ctx, opts)
+}
+
+// TokenList returns a list of API tokens of a service account using the default client.
+func TokenList(ctx context.Context, opts *doppler.ServiceAccountTokenListOptions) ([]*doppler.ServiceAccountToken, doppler.APIResponse, error) {
+	return Default().TokenList(ctx, opts)
+}
+
+func (c Client) tokenCreate(ctx context.Context, opts *doppler.ServiceAccountTokenCreateOptions) (*doppler.ServiceAccountToken, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.ServiceAccountTokenCreateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    serviceAccountPath(opts.ServiceAccount) + "/tokens",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Token, resp.APIResponse, err
+}
+
+// TokenCreate creates a new API token for a service account. The token's key is only returned once.
+func (c Client) TokenCreate(ctx context.Context, opts *doppler.ServiceAccountTokenCreateOptions) (*doppler.ServiceAccountToken, doppler.APIResponse, error) {
+	return c.tokenCreate(ctx, opts)
+}
+
+// TokenCreate creates a new API token for a service account. The token's key is only returned once using the default client.
+func TokenCreate(ctx context.Context, opts *doppler.ServiceAccountTokenCreateOptions) (*doppler.ServiceAccountToken, doppler.APIResponse, error) {
+	return Default().TokenCreate(ctx, opts)
+}
+
+func (c Client) tokenDelete(ctx context.Context, opts *doppler.ServiceAccountTokenDeleteOptions) (doppler.APIResponse, error) {
+	if opts == nil {
+		return doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.ServiceAccountTokenDeleteResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodDelete,
+		Path:    serviceAccountPath(opts.ServiceAccount) + "/tokens/token/" + url.PathEscape(opts.Slug),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.APIResponse, err
+}
+
+// TokenDelete deletes an API token of a service account.
+func (c Client) TokenDelete(ctx context.Context, opts *doppler.ServiceAccountTokenDeleteOptions) (doppler.APIResponse, error) {
+	return c.tokenDelete(ctx, opts)
+}
+
+// TokenDelete deletes an API token of a service account using the default client.
+func TokenDelete(ctx context.Context, opts *doppler.ServiceAccountTokenDeleteOptions) (doppler.APIResponse, error) {
+	return Default().TokenDelete(ctx, opts)
+}
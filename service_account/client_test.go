@@ -0,0 +1,855 @@
+package serviceaccount_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/pointer"
+	serviceaccount "github.com/nikoksr/doppler-go/service_account"
+)
+
+func TestDefault(t *testing.T) {
+	t.Parallel()
+
+	client := serviceaccount.Default()
+	if client == nil {
+		t.Fatal("Expected client to be set")
+	}
+	if client.Backend == nil {
+		t.Fatal("Expected client backend to be set")
+	}
+	if client.Key != doppler.Key {
+		t.Fatalf("Expected client key to be %q, got %q", doppler.Key, client.Key)
+	}
+}
+
+func TestServiceAccount_List(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ServiceAccountListOptions
+		wantAccounts []*doppler.ServiceAccount
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "List service accounts",
+			options: &doppler.ServiceAccountListOptions{},
+			wantAccounts: []*doppler.ServiceAccount{
+				{
+					Name: pointer.To("ci"),
+					Slug: pointer.To("ci"),
+					WorkplaceRole: &doppler.ServiceAccountWorkplaceRole{
+						Identifier: pointer.To("collaborator"),
+					},
+					CreatedAt: pointer.To("2021-01-01T00:00:00.000Z"),
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:         "List service accounts with invalid page",
+			options:      &doppler.ServiceAccountListOptions{ListOptions: doppler.ListOptions{Page: -1}},
+			wantAccounts: nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+		{
+			name:         "List service accounts with error",
+			options:      nil,
+			wantAccounts: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "403 Forbidden",
+				StatusCode: http.StatusForbidden,
+				Messages:   []string{"Missing permission"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/workplace/service_accounts" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ServiceAccountListResponse{
+					ServiceAccounts: tt.wantAccounts,
+					APIResponse:     tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &serviceaccount.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotAccounts, gotResponse, err := client.List(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantAccounts, gotAccounts); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceAccount_Get(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ServiceAccountGetOptions
+		wantAccount  *doppler.ServiceAccount
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "Get service account",
+			options: &doppler.ServiceAccountGetOptions{Slug: "ci"},
+			wantAccount: &doppler.ServiceAccount{
+				Name: pointer.To("ci"),
+				Slug: pointer.To("ci"),
+				WorkplaceRole: &doppler.ServiceAccountWorkplaceRole{
+					Identifier: pointer.To("collaborator"),
+				},
+				CreatedAt: pointer.To("2021-01-01T00:00:00.000Z"),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:        "Get service account with error",
+			options:     &doppler.ServiceAccountGetOptions{Slug: "ci"},
+			wantAccount: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Service account not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Get service account without options",
+			options:      nil,
+			wantAccount:  nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/workplace/service_accounts/service_account/ci" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ServiceAccountGetResponse{
+					ServiceAccount: tt.wantAccount,
+					APIResponse:    tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &serviceaccount.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotAccount, gotResponse, err := client.Get(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantAccount, gotAccount); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceAccount_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ServiceAccountCreateOptions
+		wantBody     map[string]any
+		wantAccount  *doppler.ServiceAccount
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "Create service account",
+			options: &doppler.ServiceAccountCreateOptions{
+				Name:          "ci",
+				WorkplaceRole: &doppler.ServiceAccountWorkplaceRole{Identifier: pointer.To("collaborator")},
+			},
+			wantBody: map[string]any{"name": "ci", "workplace_role": map[string]any{"identifier": "collaborator"}},
+			wantAccount: &doppler.ServiceAccount{
+				Name: pointer.To("ci"),
+				Slug: pointer.To("ci"),
+				WorkplaceRole: &doppler.ServiceAccountWorkplaceRole{
+					Identifier: pointer.To("collaborator"),
+				},
+				CreatedAt: pointer.To("2021-01-01T00:00:00.000Z"),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create service account with inline role and error",
+			options: &doppler.ServiceAccountCreateOptions{
+				Name:          "ci",
+				WorkplaceRole: &doppler.ServiceAccountWorkplaceRole{Permissions: []doppler.Permission{doppler.PermissionProjectCreate}},
+			},
+			wantBody:    map[string]any{"name": "ci", "workplace_role": map[string]any{"permissions": []any{"project_create"}}},
+			wantAccount: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"Invalid permission"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Create service account with ambiguous role",
+			options: &doppler.ServiceAccountCreateOptions{
+				Name: "ci",
+				WorkplaceRole: &doppler.ServiceAccountWorkplaceRole{
+					Identifier:  pointer.To("collaborator"),
+					Permissions: []doppler.Permission{doppler.PermissionProjectCreate},
+				},
+			},
+			wantAccount:  nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/workplace/service_accounts" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ServiceAccountCreateResponse{
+					ServiceAccount: tt.wantAccount,
+					APIResponse:    tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &serviceaccount.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotAccount, gotResponse, err := client.Create(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantAccount, gotAccount); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceAccount_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ServiceAccountUpdateOptions
+		wantBody     map[string]any
+		wantAccount  *doppler.ServiceAccount
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "Update workplace role",
+			options: &doppler.ServiceAccountUpdateOptions{
+				Slug:             "ci",
+				NewWorkplaceRole: &doppler.ServiceAccountWorkplaceRole{Identifier: pointer.To("collaborator")},
+			},
+			wantBody: map[string]any{"workplace_role": map[string]any{"identifier": "collaborator"}},
+			wantAccount: &doppler.ServiceAccount{
+				Name: pointer.To("ci"),
+				Slug: pointer.To("ci"),
+				WorkplaceRole: &doppler.ServiceAccountWorkplaceRole{
+					Identifier: pointer.To("collaborator"),
+				},
+				CreatedAt: pointer.To("2021-01-01T00:00:00.000Z"),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:        "Update name with error",
+			options:     &doppler.ServiceAccountUpdateOptions{Slug: "ci", NewName: pointer.To("deploy")},
+			wantBody:    map[string]any{"name": "deploy"},
+			wantAccount: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "409 Conflict",
+				StatusCode: http.StatusConflict,
+				Messages:   []string{"A service account with this name already exists"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Update without changes",
+			options:      &doppler.ServiceAccountUpdateOptions{Slug: "ci"},
+			wantAccount:  nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+		{
+			name:         "Update without options",
+			options:      nil,
+			wantAccount:  nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch || r.URL.Path != "/v3/workplace/service_accounts/service_account/ci" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ServiceAccountUpdateResponse{
+					ServiceAccount: tt.wantAccount,
+					APIResponse:    tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &serviceaccount.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotAccount, gotResponse, err := client.Update(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantAccount, gotAccount); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceAccount_Delete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ServiceAccountDeleteOptions
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "Delete service account",
+			options: &doppler.ServiceAccountDeleteOptions{Slug: "ci"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Delete service account with error",
+			options: &doppler.ServiceAccountDeleteOptions{Slug: "ci"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Service account not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Delete service account without options",
+			options:      nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/v3/workplace/service_accounts/service_account/ci" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ServiceAccountDeleteResponse{
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &serviceaccount.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotResponse, err := client.Delete(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceAccount_TokenList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ServiceAccountTokenListOptions
+		wantTokens   []*doppler.ServiceAccountToken
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "List tokens",
+			options: &doppler.ServiceAccountTokenListOptions{ServiceAccount: "ci"},
+			wantTokens: []*doppler.ServiceAccountToken{
+				{
+					Name:       pointer.To("github-actions"),
+					Slug:       pointer.To("github-actions"),
+					ExpiresAt:  pointer.To("2024-01-01T00:00:00.000Z"),
+					LastSeenAt: pointer.To("2021-06-01T00:00:00.000Z"),
+					CreatedAt:  pointer.To("2021-01-01T00:00:00.000Z"),
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:       "List tokens with error",
+			options:    &doppler.ServiceAccountTokenListOptions{ServiceAccount: "ci"},
+			wantTokens: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Service account not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "List tokens without service account",
+			options:      &doppler.ServiceAccountTokenListOptions{},
+			wantTokens:   nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/workplace/service_accounts/service_account/ci/tokens" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ServiceAccountTokenListResponse{
+					Tokens:      tt.wantTokens,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &serviceaccount.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotTokens, gotResponse, err := client.TokenList(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantTokens, gotTokens); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceAccount_TokenCreate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ServiceAccountTokenCreateOptions
+		wantBody     map[string]any
+		wantToken    *doppler.ServiceAccountToken
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "Create token",
+			options: &doppler.ServiceAccountTokenCreateOptions{
+				ServiceAccount: "ci",
+				Name:           "github-actions",
+				ExpiresAt:      pointer.To("2024-01-01T00:00:00.000Z"),
+			},
+			wantBody: map[string]any{"name": "github-actions", "expires_at": "2024-01-01T00:00:00.000Z"},
+			wantToken: &doppler.ServiceAccountToken{
+				Name:      pointer.To("github-actions"),
+				Slug:      pointer.To("github-actions"),
+				Key:       pointer.To("dp.sa.abc123"),
+				ExpiresAt: pointer.To("2024-01-01T00:00:00.000Z"),
+				CreatedAt: pointer.To("2021-01-01T00:00:00.000Z"),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:      "Create token with error",
+			options:   &doppler.ServiceAccountTokenCreateOptions{ServiceAccount: "ci", Name: "github-actions"},
+			wantBody:  map[string]any{"name": "github-actions"},
+			wantToken: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"Invalid expiration"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Create token without name",
+			options:      &doppler.ServiceAccountTokenCreateOptions{ServiceAccount: "ci"},
+			wantToken:    nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/workplace/service_accounts/service_account/ci/tokens" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ServiceAccountTokenCreateResponse{
+					Token:       tt.wantToken,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &serviceaccount.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotToken, gotResponse, err := client.TokenCreate(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantToken, gotToken); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceAccount_TokenDelete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.ServiceAccountTokenDeleteOptions
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "Delete token",
+			options: &doppler.ServiceAccountTokenDeleteOptions{ServiceAccount: "ci", Slug: "github-actions"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Delete token with error",
+			options: &doppler.ServiceAccountTokenDeleteOptions{ServiceAccount: "ci", Slug: "github-actions"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Token not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Delete token without options",
+			options:      nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/v3/workplace/service_accounts/service_account/ci/tokens/token/github-actions" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.ServiceAccountTokenDeleteResponse{
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &serviceaccount.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotResponse, err := client.TokenDelete(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+/*
+Package serviceaccount provides a client for the Doppler API's service account endpoints.
+
+API-Docs: https://docs.doppler.com/reference/service-accounts-list
+
+Example:
+
+	// Create a service account for CI and issue an API token for it
+	account, _, err := serviceaccount.Create(context.Background(), &doppler.ServiceAccountCreateOptions{
+		Name: "ci",
+		WorkplaceRole: &doppler.ServiceAccountWorkplaceRole{
+			Identifier: pointer.To("collaborator"),
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	token, _, err := serviceaccount.TokenCreate(context.Background(), &doppler.ServiceAccountTokenCreateOptions{
+		ServiceAccount: *account.Slug,
+		Name:           "github-actions",
+		ExpiresAt:      pointer.To("2024-01-01T00:00:00Z"),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(*token.Key)
+*/
+package serviceaccount
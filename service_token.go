@@ -3,15 +3,16 @@ package doppler
 type (
 	// ServiceToken represents a Doppler service token.
 	ServiceToken struct {
-		Name        *string `json:"name,omitempty"`        // Name of the service token.
-		Slug        *string `json:"slug,omitempty"`        // A unique identifier of the service token.
-		Key         *string `json:"key,omitempty"`         // An API key that is used for authentication. Only available when creating the token.
-		Project     *string `json:"project,omitempty"`     // Unique identifier for the project object.
-		Environment *string `json:"environment,omitempty"` // Unique identifier for the environment object.
-		Config      *string `json:"config,omitempty"`      // The config's name.
-		Access      *string `json:"access,omitempty"`      // The access level of the service token. One of read, read/write.
-		ExpiresAt   *string `json:"expires_at,omitempty"`  // Date and time of the token's expiration, or null if token does not auto-expire.
-		CreatedAt   *string `json:"created_at,omitempty"`  // Date and time of the object's creation.
+		Name        *string `json:"name,omitempty"`         // Name of the service token.
+		Slug        *string `json:"slug,omitempty"`         // A unique identifier of the service token.
+		Key         *string `json:"key,omitempty"`          // An API key that is used for authentication. Only available when creating the token.
+		Project     *string `json:"project,omitempty"`      // Unique identifier for the project object.
+		Environment *string `json:"environment,omitempty"`  // Unique identifier for the environment object.
+		Config      *string `json:"config,omitempty"`       // The config's name.
+		Access      *string `json:"access,omitempty"`       // The access level of the service token. One of read, read/write.
+		ExpiresAt   *string `json:"expires_at,omitempty"`   // Date and time of the token's expiration, or null if token does not auto-expire.
+		CreatedAt   *string `json:"created_at,omitempty"`   // Date and time of the object's creation.
+		LastSeenAt  *string `json:"last_seen_at,omitempty"` // Date and time of the token's last use, if reported by the API.
 	}
 
 	// ServiceTokenListResponse represents a response from the service-token list endpoint.
@@ -26,8 +27,8 @@ type (
 
 	// ServiceTokenListOptions represents the options for the service-token list endpoint.
 	ServiceTokenListOptions struct {
-		Project string `url:"project,omitempty" json:"-"` // Unique identifier for the project object.
-		Config  string `url:"config,omitempty" json:"-"`  // The config's name.
+		Project string `url:"project,omitempty" json:"-" validate:"required"` // Unique identifier for the project object.
+		Config  string `url:"config,omitempty" json:"-" validate:"required"`  // The config's name.
 	}
 
 	// ServiceTokenCreateResponse represents a response from the service-token create endpoint.
@@ -42,11 +43,11 @@ type (
 
 	// ServiceTokenCreateOptions represents the options for the service-token create endpoint.
 	ServiceTokenCreateOptions struct {
-		Project   string  `url:"-" json:"project,omitempty"`    // Unique identifier for the project object.
-		Config    string  `url:"-" json:"config,omitempty"`     // The config's name.
-		Name      string  `url:"-" json:"name,omitempty"`       // Name of the service token.
-		Access    *string `url:"-" json:"access,omitempty"`     // The access level of the service token. One of read, read/write.
-		ExpiresAt *string `url:"-" json:"expires_at,omitempty"` // Date and time of the token's expiration, or null if token does not auto-expire.
+		Project   string  `url:"-" json:"project,omitempty" validate:"required"`                       // Unique identifier for the project object.
+		Config    string  `url:"-" json:"config,omitempty" validate:"required"`                        // The config's name.
+		Name      string  `url:"-" json:"name,omitempty" validate:"required"`                          // Name of the service token.
+		Access    *string `url:"-" json:"access,omitempty" validate:"omitempty,oneof=read read/write"` // The access level of the service token. One of read, read/write.
+		ExpiresAt *string `url:"-" json:"expires_at,omitempty"`                                        // Date and time of the token's expiration, or null if token does not auto-expire.
 	}
 
 	// ServiceTokenDeleteResponse represents a response from the service-token delete endpoint.
@@ -60,8 +61,8 @@ type (
 
 	// ServiceTokenDeleteOptions represents the options for the service-token delete endpoint.
 	ServiceTokenDeleteOptions struct {
-		Project string `url:"-" json:"project,omitempty"` // Unique identifier for the project object.
-		Config  string `url:"-" json:"config,omitempty"`  // The config's name.
-		Slug    string `url:"-" json:"slug,omitempty"`    // A unique identifier of the service token.
+		Project string `url:"-" json:"project,omitempty" validate:"required"` // Unique identifier for the project object.
+		Config  string `url:"-" json:"config,omitempty" validate:"required"`  // The config's name.
+		Slug    string `url:"-" json:"slug,omitempty" validate:"required"`    // A unique identifier of the service token.
 	}
 )
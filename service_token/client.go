@@ -65,12 +65,13 @@ func Create(ctx context.Context, opts *doppler.ServiceTokenCreateOptions) (*dopp
 	return Default().Create(ctx, opts)
 }
 
-func (c Client) delete(ctx context.Context, _ *doppler.ServiceTokenDeleteOptions) (doppler.APIResponse, error) {
+func (c Client) delete(ctx context.Context, opts *doppler.ServiceTokenDeleteOptions) (doppler.APIResponse, error) {
 	var resp doppler.ServiceTokenDeleteResponse
 	err := c.Backend.Call(ctx, &doppler.Request{
-		Method: http.MethodDelete,
-		Path:   "/v3/configs/config/tokens/token",
-		Key:    c.Key,
+		Method:  http.MethodDelete,
+		Path:    "/v3/configs/config/tokens/token",
+		Key:     c.Key,
+		Payload: opts,
 	}, &resp)
 
 	return resp.APIResponse, err
@@ -0,0 +1,66 @@
+package doppler
+
+type (
+	// Sync represents a sync of a config's secrets to an integration.
+	Sync struct {
+		Slug         *string `json:"slug,omitempty"`           // Unique identifier of the sync.
+		Integration  *string `json:"integration,omitempty"`    // Unique identifier of the integration the secrets are synced to.
+		Project      *string `json:"project,omitempty"`        // Identifier of the project.
+		Config       *string `json:"config,omitempty"`         // Name of the config.
+		Enabled      *bool   `json:"enabled,omitempty"`        // Whether the sync is enabled.
+		LastSyncedAt *string `json:"last_synced_at,omitempty"` // Date and time of the last sync.
+	}
+
+	// SyncGetResponse represents a response from the sync get endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/configs/config/syncs/sync
+	// Docs:     https://docs.doppler.com/reference/syncs-get
+	SyncGetResponse struct {
+		APIResponse `json:",inline"`
+		Sync        *Sync `json:"sync,omitempty"`
+	}
+
+	// SyncGetOptions represents the options for the sync get endpoint.
+	SyncGetOptions struct {
+		Project string `url:"project" json:"-" validate:"required"` // Identifier of the project.
+		Config  string `url:"config" json:"-" validate:"required"`  // Name of the config.
+		Slug    string `url:"sync" json:"-" validate:"required"`    // Unique identifier of the sync.
+	}
+
+	// SyncCreateResponse represents a response from the sync create endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/configs/config/syncs
+	// Docs:     https://docs.doppler.com/reference/syncs-create
+	SyncCreateResponse struct {
+		APIResponse `json:",inline"`
+		Sync        *Sync `json:"sync,omitempty"`
+	}
+
+	// SyncCreateOptions represents the options for the sync create endpoint.
+	SyncCreateOptions struct {
+		Project          string         `url:"project" json:"-" validate:"required"`     // Identifier of the project.
+		Config           string         `url:"config" json:"-" validate:"required"`      // Name of the config.
+		Integration      string         `url:"-" json:"integration" validate:"required"` // Unique identifier of the integration to sync to.
+		Data             map[string]any `url:"-" json:"data" validate:"required"`        // Integration specific data of the sync, e.g. the target.
+		AwaitInitialSync *bool          `url:"-" json:"await_initial_sync,omitempty"`    // Whether to wait for the initial sync to finish before returning.
+	}
+
+	// SyncDeleteResponse represents a response from the sync delete endpoint.
+	//
+	// Method:   DELETE
+	// Endpoint: https://api.doppler.com/v3/configs/config/syncs/sync
+	// Docs:     https://docs.doppler.com/reference/syncs-delete
+	SyncDeleteResponse struct {
+		APIResponse `json:",inline"`
+	}
+
+	// SyncDeleteOptions represents the options for the sync delete endpoint.
+	SyncDeleteOptions struct {
+		Project          string `url:"project" json:"-" validate:"required"` // Identifier of the project.
+		Config           string `url:"config" json:"-" validate:"required"`  // Name of the config.
+		Slug             string `url:"sync" json:"-" validate:"required"`    // Unique identifier of the sync.
+		DeleteFromTarget bool   `url:"delete_from_target" json:"-"`          // Whether to delete the synced secrets from the target.
+	}
+)
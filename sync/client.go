@@ -0,0 +1,88 @@
+package sync
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/nikoksr/doppler-go"
+)
+
+// Client is the client used to invoke /v3/configs/config/syncs APIs.
+type Client struct {
+	Backend doppler.Backend
+	Key     string
+}
+
+// Default returns a new client based on the SDK's default backend and API key.
+func Default() *Client {
+	return &Client{
+		Backend: doppler.GetBackend(),
+		Key:     doppler.Key,
+	}
+}
+
+func (c Client) get(ctx context.Context, opts *doppler.SyncGetOptions) (*doppler.Sync, doppler.APIResponse, error) {
+	var resp doppler.SyncGetResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    "/v3/configs/config/syncs/sync",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Sync, resp.APIResponse, err
+}
+
+// Get returns a sync of a config.
+func (c Client) Get(ctx context.Context, opts *doppler.SyncGetOptions) (*doppler.Sync, doppler.APIResponse, error) {
+	return c.get(ctx, opts)
+}
+
+// Get returns a sync of a config using the default client.
+func Get(ctx context.Context, opts *doppler.SyncGetOptions) (*doppler.Sync, doppler.APIResponse, error) {
+	return Default().Get(ctx, opts)
+}
+
+func (c Client) create(ctx context.Context, opts *doppler.SyncCreateOptions) (*doppler.Sync, doppler.APIResponse, error) {
+	var resp doppler.SyncCreateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/configs/config/syncs",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Sync, resp.APIResponse, err
+}
+
+// Create creates a new sync of a config's secrets to an integration.
+func (c Client) Create(ctx context.Context, opts *doppler.SyncCreateOptions) (*doppler.Sync, doppler.APIResponse, error) {
+	return c.create(ctx, opts)
+}
+
+// Create creates a new sync of a config's secrets to an integration using the default client.
+func Create(ctx context.Context, opts *doppler.SyncCreateOptions) (*doppler.Sync, doppler.APIResponse, error) {
+	return Default().Create(ctx, opts)
+}
+
+func (c Client) delete(ctx context.Context, opts *doppler.SyncDeleteOptions) (doppler.APIResponse, error) {
+	var resp doppler.SyncDeleteResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodDelete,
+		Path:    "/v3/configs/config/syncs/sync",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.APIResponse, err
+}
+
+// Delete deletes a sync. Set DeleteFromTarget to also delete the synced secrets from the target.
+func (c Client) Delete(ctx context.Context, opts *doppler.SyncDeleteOptions) (doppler.APIResponse, error) {
+	return c.delete(ctx, opts)
+}
+
+// Delete deletes a sync. Set DeleteFromTarget to also delete the synced secrets from the target using the default client.
+func Delete(ctx context.Context, opts *doppler.SyncDeleteOptions) (doppler.APIResponse, error) {
+	return Default().Delete(ctx, opts)
+}
@@ -0,0 +1,359 @@
+package sync_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/pointer"
+	"github.com/nikoksr/doppler-go/sync"
+)
+
+func TestDefault(t *testing.T) {
+	t.Parallel()
+
+	client := sync.Default()
+	if client == nil {
+		t.Fatal("Expected client to be set")
+	}
+	if client.Backend == nil {
+		t.Fatal("Expected client backend to be set")
+	}
+	if client.Key != doppler.Key {
+		t.Fatalf("Expected client key to be %q, got %q", doppler.Key, client.Key)
+	}
+}
+
+func TestSync_Get(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.SyncGetOptions
+		wantSync     *doppler.Sync
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "Get sync",
+			options: &doppler.SyncGetOptions{Project: "backend", Config: "prd", Slug: "sync-1"},
+			wantSync: &doppler.Sync{
+				Slug:         pointer.To("sync-1"),
+				Integration:  pointer.To("aws-prod"),
+				Project:      pointer.To("backend"),
+				Config:       pointer.To("prd"),
+				Enabled:      pointer.To(true),
+				LastSyncedAt: pointer.To("2021-01-01T00:00:00.000Z"),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Get sync with error",
+			options:  &doppler.SyncGetOptions{Project: "backend", Config: "prd", Slug: "sync-1"},
+			wantSync: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Sync not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Get sync without slug",
+			options:      &doppler.SyncGetOptions{Project: "backend", Config: "prd"},
+			wantSync:     nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/configs/config/syncs/sync" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if q := r.URL.Query(); tt.options != nil && (q.Get("project") != tt.options.Project || q.Get("config") != tt.options.Config || q.Get("sync") != tt.options.Slug) {
+					t.Errorf("Unexpected query parameters: %s", r.URL.RawQuery)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.SyncGetResponse{
+					Sync:        tt.wantSync,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &sync.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotSync, gotResponse, err := client.Get(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantSync, gotSync); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSync_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.SyncCreateOptions
+		wantBody     map[string]any
+		wantSync     *doppler.Sync
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name: "Create sync",
+			options: &doppler.SyncCreateOptions{
+				Project:          "backend",
+				Config:           "prd",
+				Integration:      "aws-prod",
+				Data:             map[string]any{"region": "us-east-1"},
+				AwaitInitialSync: pointer.To(true),
+			},
+			wantBody: map[string]any{
+				"integration":        "aws-prod",
+				"data":               map[string]any{"region": "us-east-1"},
+				"await_initial_sync": true,
+			},
+			wantSync: &doppler.Sync{
+				Slug:         pointer.To("sync-1"),
+				Integration:  pointer.To("aws-prod"),
+				Project:      pointer.To("backend"),
+				Config:       pointer.To("prd"),
+				Enabled:      pointer.To(true),
+				LastSyncedAt: pointer.To("2021-01-01T00:00:00.000Z"),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create sync with error",
+			options: &doppler.SyncCreateOptions{
+				Project:     "backend",
+				Config:      "prd",
+				Integration: "aws-prod",
+				Data:        map[string]any{},
+			},
+			wantBody: map[string]any{"integration": "aws-prod", "data": map[string]any{}},
+			wantSync: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"Missing region"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Create sync without data",
+			options:      &doppler.SyncCreateOptions{Project: "backend", Config: "prd", Integration: "aws-prod"},
+			wantSync:     nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/configs/config/syncs" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.SyncCreateResponse{
+					Sync:        tt.wantSync,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &sync.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotSync, gotResponse, err := client.Create(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantSync, gotSync); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSync_Delete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                 string
+		options              *doppler.SyncDeleteOptions
+		wantDeleteFromTarget string
+		wantResponse         doppler.APIResponse
+		wantErr              bool
+	}{
+		{
+			name:                 "Delete sync",
+			options:              &doppler.SyncDeleteOptions{Project: "backend", Config: "prd", Slug: "sync-1"},
+			wantDeleteFromTarget: "false",
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:                 "Delete sync and synced secrets",
+			options:              &doppler.SyncDeleteOptions{Project: "backend", Config: "prd", Slug: "sync-1", DeleteFromTarget: true},
+			wantDeleteFromTarget: "true",
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:                 "Delete sync with error",
+			options:              &doppler.SyncDeleteOptions{Project: "backend", Config: "prd", Slug: "sync-1"},
+			wantDeleteFromTarget: "false",
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Sync not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Delete sync without options",
+			options:      nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/v3/configs/config/syncs/sync" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if got := r.URL.Query().Get("delete_from_target"); got != tt.wantDeleteFromTarget {
+					t.Errorf("Unexpected delete_from_target query parameter. Expected %q, got %q", tt.wantDeleteFromTarget, got)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.SyncDeleteResponse{
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &sync.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotResponse, err := client.Delete(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+/*
+Package sync provides a client for the Doppler API's config sync endpoints.
+
+API-Docs: https://docs.doppler.com/reference/syncs-create
+
+Example:
+
+	// Sync the prd config to an existing AWS Secrets Manager integration
+	s, _, err := sync.Create(context.Background(), &doppler.SyncCreateOptions{
+		Project:     "backend",
+		Config:      "prd",
+		Integration: "aws-prod",
+		Data: map[string]any{
+			"region": "us-east-1",
+			"path":   "/backend/prd",
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(*s.Slug)
+*/
+package sync
@@ -0,0 +1,127 @@
+package doppler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// TokenType is the type of Doppler API token, as encoded in the token's prefix.
+//
+// Docs: https://docs.doppler.com/reference/auth-token-formats
+type TokenType string
+
+const (
+	TokenTypeUnknown        TokenType = "unknown"         // The token has no known prefix, e.g. a legacy token.
+	TokenTypePersonal       TokenType = "personal"        // Personal token; prefix "dp.pt.".
+	TokenTypeService        TokenType = "service"         // Service token scoped to a single config; prefix "dp.st.".
+	TokenTypeServiceAccount TokenType = "service_account" // Service account API token; prefix "dp.sa.".
+	TokenTypeCLI            TokenType = "cli"             // CLI token; prefix "dp.ct.".
+	TokenTypeSCIM           TokenType = "scim"            // SCIM token; prefix "dp.scim.".
+	TokenTypeAudit          TokenType = "audit"           // Audit token with read-only workplace access; prefix "dp.audit.".
+)
+
+// tokenPrefixes maps the known token prefixes to their token type.
+var tokenPrefixes = []struct {
+	prefix    string
+	tokenType TokenType
+}{
+	{prefix: "dp.pt.", tokenType: TokenTypePersonal},
+	{prefix: "dp.st.", tokenType: TokenTypeService},
+	{prefix: "dp.sa.", tokenType: TokenTypeServiceAccount},
+	{prefix: "dp.ct.", tokenType: TokenTypeCLI},
+	{prefix: "dp.scim.", tokenType: TokenTypeSCIM},
+	{prefix: "dp.audit.", tokenType: TokenTypeAudit},
+}
+
+// TokenTypeOf returns the type of the given token based on its prefix. It returns TokenTypeUnknown if the token has
+// no known prefix.
+func TokenTypeOf(token string) TokenType {
+	for _, p := range tokenPrefixes {
+		if strings.HasPrefix(token, p.prefix) {
+			return p.tokenType
+		}
+	}
+
+	return TokenTypeUnknown
+}
+
+// TokenPrefix returns the prefix of the given token type, e.g. "dp.st." for service tokens. It returns an empty
+// string for TokenTypeUnknown.
+func TokenPrefix(tokenType TokenType) string {
+	for _, p := range tokenPrefixes {
+		if p.tokenType == tokenType {
+			return p.prefix
+		}
+	}
+
+	return ""
+}
+
+// tokenScope describes an API path a restricted token type has access to.
+type tokenScope struct {
+	method string // HTTP method; empty for any method.
+	path   string // Exact path or, if it ends with a slash, a path prefix.
+}
+
+// matches reports whether the scope covers the given request.
+func (s tokenScope) matches(method, path string) bool {
+	if s.method != "" && s.method != method {
+		return false
+	}
+	if strings.HasSuffix(s.path, "/") {
+		return strings.HasPrefix(path, s.path) || path == strings.TrimSuffix(s.path, "/")
+	}
+
+	return path == s.path
+}
+
+// restrictedTokenScopes lists the endpoints available to the restricted token types. Token types which aren't listed
+// are only restricted by the permissions of their user or service account, which the SDK can't know about.
+var restrictedTokenScopes = map[TokenType][]tokenScope{
+	TokenTypeService: {
+		{method: http.MethodGet, path: "/v3/me"},
+		{method: http.MethodGet, path: "/v3/configs/config"},
+		{path: "/v3/configs/config/secret"},
+		{path: "/v3/configs/config/secrets/"},
+		{path: "/v3/configs/config/dynamic_secrets/"},
+		{path: "/v1/share/"},
+	},
+	TokenTypeAudit: {
+		{method: http.MethodGet, path: "/v3/me"},
+		{method: http.MethodGet, path: "/v3/workplace"},
+		{method: http.MethodGet, path: "/v3/workplace/users/"},
+	},
+	TokenTypeSCIM: {
+		{path: "/scim/"},
+	},
+}
+
+// TokenScopeError is returned by the backend if a request is bound to fail, because the type of the used token has
+// no access to the requested endpoint. It's returned before the request is sent.
+type TokenScopeError struct {
+	TokenType TokenType // Type of the token used for the request.
+	Method    string    // HTTP method of the request.
+	Path      string    // Path of the request.
+}
+
+// Error returns the error message.
+func (e *TokenScopeError) Error() string {
+	return fmt.Sprintf("%s token has no access to %s %s", e.TokenType, e.Method, e.Path)
+}
+
+// checkTokenScope returns a TokenScopeError if a token of the given type has no access to the given endpoint.
+func checkTokenScope(tokenType TokenType, method, path string) error {
+	scopes, restricted := restrictedTokenScopes[tokenType]
+	if !restricted {
+		return nil
+	}
+
+	for _, scope := range scopes {
+		if scope.matches(method, path) {
+			return nil
+		}
+	}
+
+	return &TokenScopeError{TokenType: tokenType, Method: method, Path: path}
+}
@@ -0,0 +1,113 @@
+package doppler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nikoksr/doppler-go/pointer"
+)
+
+func TestTokenTypeOf(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]TokenType{
+		"dp.pt.abc":    TokenTypePersonal,
+		"dp.st.abc":    TokenTypeService,
+		"dp.sa.abc":    TokenTypeServiceAccount,
+		"dp.ct.abc":    TokenTypeCLI,
+		"dp.scim.abc":  TokenTypeSCIM,
+		"dp.audit.abc": TokenTypeAudit,
+		"abc":          TokenTypeUnknown,
+		"":             TokenTypeUnknown,
+	}
+	for token, want := range cases {
+		if got := TokenTypeOf(token); got != want {
+			t.Errorf("TokenTypeOf(%q) = %q, want %q", token, got, want)
+		}
+	}
+}
+
+func Test_checkTokenScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		tokenType TokenType
+		method    string
+		path      string
+		wantErr   bool
+	}{
+		{"Personal token creates project", TokenTypePersonal, http.MethodPost, "/v3/projects", false},
+		{"Unknown token creates project", TokenTypeUnknown, http.MethodPost, "/v3/projects", false},
+		{"Service token creates project", TokenTypeService, http.MethodPost, "/v3/projects", true},
+		{"Service token lists secrets", TokenTypeService, http.MethodGet, "/v3/configs/config/secrets", false},
+		{"Service token downloads secrets", TokenTypeService, http.MethodGet, "/v3/configs/config/secrets/download", false},
+		{"Service token updates secrets", TokenTypeService, http.MethodPost, "/v3/configs/config/secrets", false},
+		{"Service token gets secret", TokenTypeService, http.MethodGet, "/v3/configs/config/secret", false},
+		{"Service token lists config logs", TokenTypeService, http.MethodGet, "/v3/configs/config/logs", true},
+		{"Audit token lists secrets", TokenTypeAudit, http.MethodGet, "/v3/configs/config/secrets", true},
+		{"Audit token gets workplace", TokenTypeAudit, http.MethodGet, "/v3/workplace", false},
+		{"Audit token updates workplace", TokenTypeAudit, http.MethodPost, "/v3/workplace", true},
+		{"Audit token gets workplace user", TokenTypeAudit, http.MethodGet, "/v3/workplace/users/123", false},
+		{"SCIM token lists projects", TokenTypeSCIM, http.MethodGet, "/v3/projects", true},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkTokenScope(tc.tokenType, tc.method, tc.path)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("checkTokenScope() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestBackendImplementation_TokenScope(t *testing.T) {
+	t.Parallel()
+
+	// Create a fake HTTP server that counts the requests it receives.
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// A service token can't create projects; the request must never be sent.
+	backend := GetBackendWithConfig(&BackendConfig{URL: pointer.To(server.URL), CheckTokenScope: true})
+	err := backend.Call(context.Background(), &Request{
+		Method: http.MethodPost,
+		Path:   "/v3/projects",
+		Key:    "dp.st.dev.abc",
+	}, nil)
+
+	var scopeErr *TokenScopeError
+	if !errors.As(err, &scopeErr) {
+		t.Fatalf("Call() error = %v, want a TokenScopeError", err)
+	}
+	if scopeErr.TokenType != TokenTypeService {
+		t.Errorf("Expected token type %q, got %q", TokenTypeService, scopeErr.TokenType)
+	}
+	if requests != 0 {
+		t.Errorf("Expected no requests, got %d", requests)
+	}
+
+	// The check is disabled by default.
+	backend = GetBackendWithConfig(&BackendConfig{URL: pointer.To(server.URL)})
+	err = backend.Call(context.Background(), &Request{
+		Method: http.MethodPost,
+		Path:   "/v3/projects",
+		Key:    "dp.st.dev.abc",
+	}, nil)
+	if err != nil {
+		t.Fatalf("Call() returned an error: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+}
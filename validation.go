@@ -0,0 +1,97 @@
+package doppler
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+)
+
+// validate is the validator used for all options. It's safe for concurrent use and caches struct metadata, hence it's
+// shared.
+var validate = validator.New()
+
+// FieldError describes a single option that failed validation.
+type FieldError struct {
+	// Field is the path of the invalid field, e.g. "ProjectGetOptions.Name" or "AuthRevokeOptions.Tokens[0].Token".
+	Field string `json:"field"`
+
+	// Rule is the name of the violated validation rule, e.g. "required" or "max".
+	Rule string `json:"rule"`
+
+	// Param is the parameter of the violated validation rule, e.g. "50" for "max=50". It's empty for rules without a
+	// parameter.
+	Param string `json:"param,omitempty"`
+
+	// Value is the invalid value.
+	Value any `json:"value,omitempty"`
+}
+
+// String returns a human-readable description of the field error.
+func (e FieldError) String() string {
+	if e.Param == "" {
+		return fmt.Sprintf("%s violates rule %q", e.Field, e.Rule)
+	}
+
+	return fmt.Sprintf("%s violates rule %q with parameter %q", e.Field, e.Rule, e.Param)
+}
+
+// ValidationError is returned if the options of a request are invalid. It's returned before the request is sent.
+type ValidationError struct {
+	Fields []FieldError `json:"fields"`
+}
+
+// Error returns the error message, listing all invalid fields.
+func (e *ValidationError) Error() string {
+	descriptions := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		descriptions = append(descriptions, field.String())
+	}
+
+	return "invalid options: " + strings.Join(descriptions, "; ")
+}
+
+// ValidateOptions validates the given options against their validate tags. It returns a *ValidationError if any of
+// the options are invalid. A nil pointer is validated like a pointer to the zero value, so that options with required
+// fields can't be skipped by passing nil. Values that aren't structs or pointers to structs are considered valid.
+func ValidateOptions(opts any) error {
+	value := reflect.ValueOf(opts)
+	if !value.IsValid() {
+		return nil
+	}
+
+	// Dereference pointers; nil pointers are validated as zero values.
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			value = reflect.New(value.Type().Elem())
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+
+	err := validate.Struct(value.Addr().Interface())
+	if err == nil {
+		return nil
+	}
+
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return errors.Wrap(err, "validate options")
+	}
+
+	fields := make([]FieldError, 0, len(validationErrs))
+	for _, fieldErr := range validationErrs {
+		fields = append(fields, FieldError{
+			Field: fieldErr.StructNamespace(),
+			Rule:  fieldErr.Tag(),
+			Param: fieldErr.Param(),
+			Value: fieldErr.Value(),
+		})
+	}
+
+	return &ValidationError{Fields: fields}
+}
@@ -0,0 +1,139 @@
+package doppler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/nikoksr/doppler-go/pointer"
+)
+
+func TestValidateOptions(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		opts       any
+		wantFields []string
+	}{
+		{
+			name: "nil",
+			opts: nil,
+		},
+		{
+			name: "nil list options",
+			opts: (*ProjectListOptions)(nil),
+		},
+		{
+			name:       "nil get options",
+			opts:       (*ProjectGetOptions)(nil),
+			wantFields: []string{"ProjectGetOptions.Name"},
+		},
+		{
+			name:       "empty config get options",
+			opts:       &ConfigGetOptions{},
+			wantFields: []string{"ConfigGetOptions.Project", "ConfigGetOptions.Config"},
+		},
+		{
+			name: "valid config get options",
+			opts: &ConfigGetOptions{Project: "p", Config: "c"},
+		},
+		{
+			name: "share unlimited views",
+			opts: &SharePlainOptions{Secret: "s", ExpireViews: pointer.To[int32](-1), ExpireDays: pointer.To[int32](90)},
+		},
+		{
+			name:       "share zero views",
+			opts:       &SharePlainOptions{Secret: "s", ExpireViews: pointer.To[int32](0)},
+			wantFields: []string{"SharePlainOptions.ExpireViews"},
+		},
+		{
+			name:       "share too many views and days",
+			opts:       &SharePlainOptions{Secret: "s", ExpireViews: pointer.To[int32](51), ExpireDays: pointer.To[int32](91)},
+			wantFields: []string{"SharePlainOptions.ExpireViews", "SharePlainOptions.ExpireDays"},
+		},
+		{
+			name:       "encrypted share with wrong kdf",
+			opts:       &ShareEncryptedOptions{Secret: "s", Password: "p", KDF: "scrypt", SaltRounds: EncryptionSaltRounds},
+			wantFields: []string{"ShareEncryptedOptions.KDF"},
+		},
+		{
+			name: "service token with read/write access",
+			opts: &ServiceTokenCreateOptions{Project: "p", Config: "c", Name: "n", Access: pointer.To("read/write")},
+		},
+		{
+			name:       "service token with invalid access",
+			opts:       &ServiceTokenCreateOptions{Project: "p", Config: "c", Name: "n", Access: pointer.To("write")},
+			wantFields: []string{"ServiceTokenCreateOptions.Access"},
+		},
+		{
+			name:       "revoke empty token",
+			opts:       &AuthRevokeOptions{Tokens: []AuthToken{{Token: pointer.To("t")}, {}}},
+			wantFields: []string{"AuthRevokeOptions.Tokens[1].Token"},
+		},
+		{
+			name:       "environment rename without changes",
+			opts:       &EnvironmentRenameOptions{Project: "p", Slug: "s"},
+			wantFields: []string{"EnvironmentRenameOptions.NewName", "EnvironmentRenameOptions.NewSlug"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateOptions(tc.opts)
+			if len(tc.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("ValidateOptions() returned an error: %v", err)
+				}
+				return
+			}
+
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("ValidateOptions() error = %v, want a ValidationError", err)
+			}
+
+			gotFields := make([]string, 0, len(validationErr.Fields))
+			for _, field := range validationErr.Fields {
+				gotFields = append(gotFields, field.Field)
+			}
+			if diff := cmp.Diff(tc.wantFields, gotFields); diff != "" {
+				t.Errorf("ValidateOptions() fields mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBackendImplementation_Validation(t *testing.T) {
+	t.Parallel()
+
+	// Create a fake HTTP server that counts the requests it receives.
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	backend := GetBackendWithConfig(&BackendConfig{URL: pointer.To(server.URL)})
+	err := backend.Call(context.Background(), &Request{
+		Method:  http.MethodGet,
+		Path:    "/v3/projects/project",
+		Payload: &ProjectGetOptions{},
+	}, nil)
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Call() error = %v, want a ValidationError", err)
+	}
+	if requests != 0 {
+		t.Errorf("Expected no requests, got %d", requests)
+	}
+}
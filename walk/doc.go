@@ -0,0 +1,29 @@
+/*
+Package walk provides a walker over every config in every environment in every project of a workplace.
+
+Example:
+
+	// Print every config of the workplace, skipping the environments of archived projects.
+	err := walk.Workplace(context.Background(), func(ctx context.Context, node *walk.Node) error {
+		if node.Kind == walk.KindProject && strings.HasPrefix(*node.Project.Slug, "archived-") {
+			return walk.SkipSubtree
+		}
+		if node.Kind == walk.KindConfig {
+			fmt.Println(node.Path())
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Cache the workplace's inventory for offline analysis.
+	snapshot, err := walk.TakeSnapshot(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := snapshot.Save(file); err != nil {
+		log.Fatal(err)
+	}
+*/
+package walk
@@ -0,0 +1,133 @@
+package walk
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/nikoksr/doppler-go"
+)
+
+type (
+	// Snapshot represents the projects, environments and configs of a workplace at a point in time. It serializes to
+	// JSON, so it can be cached and analyzed offline.
+	Snapshot struct {
+		TakenAt  time.Time          `json:"taken_at"` // Time the snapshot was taken.
+		Projects []*ProjectSnapshot `json:"projects"` // Projects of the workplace.
+	}
+
+	// ProjectSnapshot represents a project and its environments.
+	ProjectSnapshot struct {
+		Project      *doppler.Project       `json:"project"`      // The project.
+		Environments []*EnvironmentSnapshot `json:"environments"` // Environments of the project.
+	}
+
+	// EnvironmentSnapshot represents an environment and its configs.
+	EnvironmentSnapshot struct {
+		Environment *doppler.Environment `json:"environment"` // The environment.
+		Configs     []*doppler.Config    `json:"configs"`     // Configs of the environment.
+	}
+)
+
+func (c Client) snapshot(ctx context.Context) (*Snapshot, error) {
+	snapshot := &Snapshot{TakenAt: time.Now().UTC(), Projects: []*ProjectSnapshot{}}
+
+	var (
+		project *ProjectSnapshot
+		env     *EnvironmentSnapshot
+	)
+	err := c.workplace(ctx, func(_ context.Context, node *Node) error {
+		switch node.Kind {
+		case KindProject:
+			project = &ProjectSnapshot{Project: node.Project, Environments: []*EnvironmentSnapshot{}}
+			snapshot.Projects = append(snapshot.Projects, project)
+		case KindEnvironment:
+			env = &EnvironmentSnapshot{Environment: node.Environment, Configs: []*doppler.Config{}}
+			project.Environments = append(project.Environments, env)
+		case KindConfig:
+			env.Configs = append(env.Configs, node.Config)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// Snapshot walks the workplace and returns a snapshot of its projects, environments and configs.
+func (c Client) Snapshot(ctx context.Context) (*Snapshot, error) {
+	return c.snapshot(ctx)
+}
+
+// TakeSnapshot walks the workplace and returns a snapshot of its projects, environments and configs using the default
+// client.
+func TakeSnapshot(ctx context.Context) (*Snapshot, error) {
+	return Default().Snapshot(ctx)
+}
+
+// Walk walks the snapshot like Workplace walks the live workplace, without calling the API.
+func (s *Snapshot) Walk(ctx context.Context, fn Func) error {
+	err := s.walk(ctx, fn)
+	if errors.Is(err, SkipAll) {
+		return nil
+	}
+
+	return err
+}
+
+func (s *Snapshot) walk(ctx context.Context, fn Func) error {
+	for _, p := range s.Projects {
+		node := &Node{Kind: KindProject, Project: p.Project}
+		skip, err := visit(ctx, fn, node)
+		if err != nil {
+			return err
+		}
+		if skip {
+			continue
+		}
+
+		for _, e := range p.Environments {
+			node := &Node{Kind: KindEnvironment, Project: p.Project, Environment: e.Environment}
+			skip, err := visit(ctx, fn, node)
+			if err != nil {
+				return err
+			}
+			if skip {
+				continue
+			}
+
+			for _, cfg := range e.Configs {
+				node := &Node{Kind: KindConfig, Project: p.Project, Environment: e.Environment, Config: cfg}
+				if _, err := visit(ctx, fn, node); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Save writes the snapshot as JSON.
+func (s *Snapshot) Save(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return errors.Wrap(enc.Encode(s), "encode snapshot")
+}
+
+// LoadSnapshot reads a snapshot written by Snapshot.Save.
+func LoadSnapshot(r io.Reader) (*Snapshot, error) {
+	var snapshot Snapshot
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return nil, errors.Wrap(err, "decode snapshot")
+	}
+
+	return &snapshot, nil
+}
@@ -0,0 +1,208 @@
+package walk
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/config"
+	"github.com/nikoksr/doppler-go/environment"
+	"github.com/nikoksr/doppler-go/project"
+)
+
+var (
+	// SkipSubtree is returned by a visitor to skip the environments of the visited project, or the configs of the
+	// visited environment. Returned for a config, it has no effect.
+	SkipSubtree = errors.New("skip this subtree")
+
+	// SkipAll is returned by a visitor to end the walk without error.
+	SkipAll = errors.New("skip everything and stop the walk")
+)
+
+// Kind is the kind of a node of the workplace tree.
+type Kind string
+
+const (
+	// KindProject is the kind of project nodes.
+	KindProject Kind = "project"
+
+	// KindEnvironment is the kind of environment nodes.
+	KindEnvironment Kind = "environment"
+
+	// KindConfig is the kind of config nodes.
+	KindConfig Kind = "config"
+)
+
+// Node represents a visited node of the workplace tree. The fields of the node's ancestors are set as well, e.g. a
+// config node carries its project and environment.
+type Node struct {
+	Kind        Kind                 // Kind of the node.
+	Project     *doppler.Project     // The visited project, or the project of the visited environment or config.
+	Environment *doppler.Environment // The visited environment, or the environment of the visited config.
+	Config      *doppler.Config      // The visited config.
+}
+
+// Path returns the path of the node, e.g. "project/environment/config".
+func (n *Node) Path() string {
+	path := slug(n.Project.Slug, n.Project.ID)
+	if n.Environment != nil {
+		path += "/" + slug(n.Environment.Slug, n.Environment.ID)
+	}
+	if n.Config != nil && n.Config.Name != nil {
+		path += "/" + *n.Config.Name
+	}
+
+	return path
+}
+
+// Func is called for every visited node. Returning SkipSubtree skips the node's children, returning SkipAll ends the
+// walk, and returning any other error aborts the walk with that error.
+type Func func(ctx context.Context, node *Node) error
+
+// Client is the client used to walk the projects, environments and configs of a workplace.
+type Client struct {
+	Backend doppler.Backend
+	Key     string
+}
+
+// Default returns a new client based on the SDK's default backend and API key.
+func Default() *Client {
+	return &Client{
+		Backend: doppler.GetBackend(),
+		Key:     doppler.Key,
+	}
+}
+
+// slug returns the slug, falling back to the ID if the slug is unset.
+func slug(slug, id *string) string {
+	if slug != nil && *slug != "" {
+		return *slug
+	}
+	if id != nil {
+		return *id
+	}
+
+	return ""
+}
+
+// visit calls the visitor and reports whether the node's children should be skipped.
+func visit(ctx context.Context, fn Func, node *Node) (skip bool, err error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	err = fn(ctx, node)
+	if errors.Is(err, SkipSubtree) {
+		return true, nil
+	}
+
+	return false, err
+}
+
+// walkProjects calls the visitor for every project of the workplace, and walks the environments of every project that
+// isn't skipped.
+func (c Client) walkProjects(ctx context.Context, fn Func) error {
+	projects := &project.Client{Backend: c.Backend, Key: c.Key}
+	it := doppler.NewPageIterator(ctx, doppler.ListOptions{}, func(ctx context.Context, page doppler.ListOptions) ([]*doppler.Project, error) {
+		list, _, err := projects.List(ctx, &doppler.ProjectListOptions{ListOptions: page})
+		return list, err
+	})
+
+	for {
+		p, err := it.Next()
+		if errors.Is(err, doppler.ErrIteratorDone) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "list projects")
+		}
+
+		node := &Node{Kind: KindProject, Project: p}
+		skip, err := visit(ctx, fn, node)
+		if err != nil {
+			return err
+		}
+		if skip {
+			continue
+		}
+		if err := c.walkEnvironments(ctx, fn, node); err != nil {
+			return err
+		}
+	}
+}
+
+// walkEnvironments calls the visitor for every environment of the project, and walks the configs of every environment
+// that isn't skipped.
+func (c Client) walkEnvironments(ctx context.Context, fn Func, parent *Node) error {
+	environments := &environment.Client{Backend: c.Backend, Key: c.Key}
+	project := slug(parent.Project.Slug, parent.Project.ID)
+
+	list, _, err := environments.List(ctx, &doppler.EnvironmentListOptions{Project: project})
+	if err != nil {
+		return errors.Wrapf(err, "list environments of project %s", project)
+	}
+
+	for _, env := range list {
+		node := &Node{Kind: KindEnvironment, Project: parent.Project, Environment: env}
+		skip, err := visit(ctx, fn, node)
+		if err != nil {
+			return err
+		}
+		if skip {
+			continue
+		}
+		if err := c.walkConfigs(ctx, fn, node); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walkConfigs calls the visitor for every config of the environment.
+func (c Client) walkConfigs(ctx context.Context, fn Func, parent *Node) error {
+	configs := &config.Client{Backend: c.Backend, Key: c.Key}
+	project := slug(parent.Project.Slug, parent.Project.ID)
+	env := slug(parent.Environment.Slug, parent.Environment.ID)
+
+	it := doppler.NewPageIterator(ctx, doppler.ListOptions{}, func(ctx context.Context, page doppler.ListOptions) ([]*doppler.Config, error) {
+		list, _, err := configs.List(ctx, &doppler.ConfigListOptions{ListOptions: page, Project: project, Environment: &env})
+		return list, err
+	})
+
+	for {
+		cfg, err := it.Next()
+		if errors.Is(err, doppler.ErrIteratorDone) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "list configs of environment %s/%s", project, env)
+		}
+
+		node := &Node{Kind: KindConfig, Project: parent.Project, Environment: parent.Environment, Config: cfg}
+		if _, err := visit(ctx, fn, node); err != nil {
+			return err
+		}
+	}
+}
+
+func (c Client) workplace(ctx context.Context, fn Func) error {
+	err := c.walkProjects(ctx, fn)
+	if errors.Is(err, SkipAll) {
+		return nil
+	}
+
+	return err
+}
+
+// Workplace walks every config in every environment in every project of the workplace, calling the visitor for every
+// project, environment and config in that order. Projects and configs are fetched page by page.
+func (c Client) Workplace(ctx context.Context, fn Func) error {
+	return c.workplace(ctx, fn)
+}
+
+// Workplace walks every config in every environment in every project of the workplace using the default client.
+func Workplace(ctx context.Context, fn Func) error {
+	return Default().Workplace(ctx, fn)
+}
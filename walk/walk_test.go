@@ -0,0 +1,300 @@
+package walk_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/internal/mockapi"
+	"github.com/nikoksr/doppler-go/pointer"
+	"github.com/nikoksr/doppler-go/walk"
+)
+
+// inventory represents the mocked projects, environments and configs of a workplace.
+type inventory struct {
+	projects     []*doppler.Project
+	environments map[string][]*doppler.Environment // By project.
+	configs      map[string][]*doppler.Config      // By project/environment.
+}
+
+func testInventory() *inventory {
+	return &inventory{
+		projects: []*doppler.Project{
+			{ID: pointer.To("backend"), Slug: pointer.To("backend")},
+			{ID: pointer.To("frontend"), Slug: pointer.To("frontend")},
+		},
+		environments: map[string][]*doppler.Environment{
+			"backend": {
+				{ID: pointer.To("dev"), Slug: pointer.To("dev"), Project: pointer.To("backend")},
+				{ID: pointer.To("prd"), Slug: pointer.To("prd"), Project: pointer.To("backend")},
+			},
+			"frontend": {
+				{ID: pointer.To("prd"), Slug: pointer.To("prd"), Project: pointer.To("frontend")},
+			},
+		},
+		configs: map[string][]*doppler.Config{
+			"backend/dev": {
+				{Name: pointer.To("dev"), Project: pointer.To("backend"), Environment: pointer.To("dev"), Root: pointer.To(true)},
+				{Name: pointer.To("dev_personal"), Project: pointer.To("backend"), Environment: pointer.To("dev")},
+			},
+			"backend/prd": {
+				{Name: pointer.To("prd"), Project: pointer.To("backend"), Environment: pointer.To("prd"), Root: pointer.To(true)},
+			},
+			"frontend/prd": {
+				{Name: pointer.To("prd"), Project: pointer.To("frontend"), Environment: pointer.To("prd"), Root: pointer.To(true)},
+			},
+		},
+	}
+}
+
+// serve starts a server mocking the list endpoints the inventory is walked through.
+func (inv *inventory) serve(t *testing.T) *mockapi.Server {
+	return mockapi.New(t, map[string]mockapi.Route{
+		"/v3/projects": func(r *http.Request) any {
+			return &doppler.ProjectListResponse{APIResponse: mockapi.OK(), Projects: mockapi.Page(r, inv.projects)}
+		},
+		"/v3/environments": func(r *http.Request) any {
+			return &doppler.EnvironmentListResponse{APIResponse: mockapi.OK(), Environments: inv.environments[r.URL.Query().Get("project")]}
+		},
+		"/v3/configs": func(r *http.Request) any {
+			query := r.URL.Query()
+			configs := inv.configs[query.Get("project")+"/"+query.Get("environment")]
+			return &doppler.ConfigListResponse{APIResponse: mockapi.OK(), Configs: mockapi.Page(r, configs)}
+		},
+	})
+}
+
+func newClient(srv *mockapi.Server) *walk.Client {
+	return &walk.Client{Backend: srv.Backend(), Key: "test"}
+}
+
+// visitor returns a visitor recording the visited paths. The visitor returns the error mapped to a path, if any.
+func visitor(paths *[]string, errs map[string]error) walk.Func {
+	return func(_ context.Context, node *walk.Node) error {
+		*paths = append(*paths, string(node.Kind)+":"+node.Path())
+		return errs[node.Path()]
+	}
+}
+
+// allPaths are the paths visited by a full walk of testInventory.
+var allPaths = []string{
+	"project:backend",
+	"environment:backend/dev",
+	"config:backend/dev/dev",
+	"config:backend/dev/dev_personal",
+	"environment:backend/prd",
+	"config:backend/prd/prd",
+	"project:frontend",
+	"environment:frontend/prd",
+	"config:frontend/prd/prd",
+}
+
+var errVisitor = errors.New("visitor failed")
+
+func TestWalk_Workplace(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		errs      map[string]error
+		failPath  string
+		wantPaths []string
+		wantErr   bool
+		wantErrIs error
+	}{
+		{
+			name:      "Walk workplace",
+			wantPaths: allPaths,
+		},
+		{
+			name: "Walk workplace skipping a project",
+			errs: map[string]error{"backend": walk.SkipSubtree},
+			wantPaths: []string{
+				"project:backend",
+				"project:frontend",
+				"environment:frontend/prd",
+				"config:frontend/prd/prd",
+			},
+		},
+		{
+			name: "Walk workplace skipping an environment",
+			errs: map[string]error{"backend/dev": walk.SkipSubtree},
+			wantPaths: []string{
+				"project:backend",
+				"environment:backend/dev",
+				"environment:backend/prd",
+				"config:backend/prd/prd",
+				"project:frontend",
+				"environment:frontend/prd",
+				"config:frontend/prd/prd",
+			},
+		},
+		{
+			name:      "Walk workplace skipping a config",
+			errs:      map[string]error{"backend/dev/dev": walk.SkipSubtree},
+			wantPaths: allPaths,
+		},
+		{
+			name:      "Walk workplace skipping all",
+			errs:      map[string]error{"backend/dev/dev_personal": walk.SkipAll},
+			wantPaths: allPaths[:4],
+		},
+		{
+			name:      "Walk workplace with failing visitor",
+			errs:      map[string]error{"backend/prd": errVisitor},
+			wantPaths: allPaths[:5],
+			wantErr:   true,
+			wantErrIs: errVisitor,
+		},
+		{
+			name:      "Walk workplace with failing config list",
+			failPath:  "/v3/configs",
+			wantPaths: allPaths[:2],
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := testInventory().serve(t)
+			srv.Fail(tt.failPath)
+
+			var gotPaths []string
+			err := newClient(srv).Workplace(context.Background(), visitor(&gotPaths, tt.errs))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unexpected error. Expected %t, got %v", tt.wantErr, err)
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("Unexpected error. Expected %v, got %v", tt.wantErrIs, err)
+			}
+			if diff := cmp.Diff(tt.wantPaths, gotPaths); diff != "" {
+				t.Errorf("Unexpected paths (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWalk_WorkplacePagination(t *testing.T) {
+	t.Parallel()
+
+	inv := &inventory{
+		environments: map[string][]*doppler.Environment{"project-000": {{Slug: pointer.To("dev")}}},
+		configs:      map[string][]*doppler.Config{},
+	}
+	for i := 0; i < doppler.DefaultIteratorPerPage+1; i++ {
+		inv.projects = append(inv.projects, &doppler.Project{Slug: pointer.To(fmt.Sprintf("project-%03d", i))})
+	}
+	for i := 0; i < doppler.DefaultIteratorPerPage; i++ {
+		cfg := &doppler.Config{Name: pointer.To(fmt.Sprintf("dev_%03d", i))}
+		inv.configs["project-000/dev"] = append(inv.configs["project-000/dev"], cfg)
+	}
+	srv := inv.serve(t)
+
+	counts := make(map[walk.Kind]int)
+	err := newClient(srv).Workplace(context.Background(), func(_ context.Context, node *walk.Node) error {
+		counts[node.Kind]++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	wantCounts := map[walk.Kind]int{
+		walk.KindProject:     doppler.DefaultIteratorPerPage + 1,
+		walk.KindEnvironment: 1,
+		walk.KindConfig:      doppler.DefaultIteratorPerPage,
+	}
+	if diff := cmp.Diff(wantCounts, counts); diff != "" {
+		t.Errorf("Unexpected node counts (-want +got):\n%s", diff)
+	}
+
+	// Two pages of projects; a full page of configs is followed by an empty one.
+	wantRequests := map[string]int{
+		"/v3/projects":     2,
+		"/v3/environments": doppler.DefaultIteratorPerPage + 1,
+		"/v3/configs":      2,
+	}
+	gotRequests := make(map[string]int)
+	for path := range wantRequests {
+		gotRequests[path] = srv.Requests(path)
+	}
+	if diff := cmp.Diff(wantRequests, gotRequests); diff != "" {
+		t.Errorf("Unexpected requests (-want +got):\n%s", diff)
+	}
+}
+
+func TestWalk_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	inv := testInventory()
+	srv := inv.serve(t)
+
+	snapshot, err := newClient(srv).Snapshot(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	wantSnapshot := &walk.Snapshot{Projects: []*walk.ProjectSnapshot{
+		{Project: inv.projects[0], Environments: []*walk.EnvironmentSnapshot{
+			{Environment: inv.environments["backend"][0], Configs: inv.configs["backend/dev"]},
+			{Environment: inv.environments["backend"][1], Configs: inv.configs["backend/prd"]},
+		}},
+		{Project: inv.projects[1], Environments: []*walk.EnvironmentSnapshot{
+			{Environment: inv.environments["frontend"][0], Configs: inv.configs["frontend/prd"]},
+		}},
+	}}
+	if diff := cmp.Diff(wantSnapshot, snapshot, cmpopts.IgnoreFields(walk.Snapshot{}, "TakenAt")); diff != "" {
+		t.Errorf("Unexpected snapshot (-want +got):\n%s", diff)
+	}
+
+	// Round-trip the snapshot through JSON.
+	var buf bytes.Buffer
+	if err := snapshot.Save(&buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	loaded, err := walk.LoadSnapshot(&buf)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(snapshot, loaded); diff != "" {
+		t.Errorf("Unexpected loaded snapshot (-want +got):\n%s", diff)
+	}
+
+	// Walking the snapshot must not call the API.
+	srv.Close()
+
+	var gotPaths []string
+	errs := map[string]error{"backend/dev": walk.SkipSubtree, "frontend/prd": walk.SkipAll}
+	if err := loaded.Walk(context.Background(), visitor(&gotPaths, errs)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	wantPaths := []string{
+		"project:backend",
+		"environment:backend/dev",
+		"environment:backend/prd",
+		"config:backend/prd/prd",
+		"project:frontend",
+		"environment:frontend/prd",
+	}
+	if diff := cmp.Diff(wantPaths, gotPaths); diff != "" {
+		t.Errorf("Unexpected paths (-want +got):\n%s", diff)
+	}
+}
+
+func TestLoadSnapshot(t *testing.T) {
+	t.Parallel()
+
+	if _, err := walk.LoadSnapshot(bytes.NewBufferString("{")); err == nil {
+		t.Error("Expected error for malformed snapshot, got nil")
+	}
+}
@@ -0,0 +1,159 @@
+package doppler
+
+import "encoding/json"
+
+// WebhookEventType is the type of event a webhook delivery was triggered by.
+type WebhookEventType string
+
+const (
+	// WebhookEventConfigSecretsUpdate is sent whenever the secrets of a config have changed.
+	WebhookEventConfigSecretsUpdate WebhookEventType = "config.secrets.update"
+
+	// WebhookEventWebhookTest is sent when a webhook is tested from the dashboard.
+	WebhookEventWebhookTest WebhookEventType = "webhook.test"
+)
+
+type (
+	// Webhook represents a webhook of a Doppler project.
+	Webhook struct {
+		ID             *string  `json:"id,omitempty"`              // Unique identifier of the webhook.
+		Slug           *string  `json:"slug,omitempty"`            // Slug of the webhook.
+		Name           *string  `json:"name,omitempty"`            // Name of the webhook.
+		URL            *string  `json:"url,omitempty"`             // URL the webhook deliveries are sent to.
+		Enabled        *bool    `json:"enabled,omitempty"`         // Whether the webhook is enabled.
+		EnabledConfigs []string `json:"enabled_configs,omitempty"` // Names of the configs whose changes trigger the webhook.
+		Payload        *string  `json:"payload,omitempty"`         // Custom payload template. The default payload is sent if empty.
+		CreatedAt      *string  `json:"created_at,omitempty"`      // Date and time of the object's creation.
+	}
+
+	// WebhookEvent represents the default payload of a webhook delivery.
+	WebhookEvent struct {
+		Type      WebhookEventType `json:"type"`                // Type of the event.
+		Project   *Project         `json:"project,omitempty"`   // Project the event happened in.
+		Config    *Config          `json:"config,omitempty"`    // Config the event happened in.
+		Workplace *Workplace       `json:"workplace,omitempty"` // Workplace the event happened in.
+
+		// Raw is the raw body of the delivery. It's useful when the webhook uses a custom payload template.
+		Raw json.RawMessage `json:"-"`
+	}
+
+	// WebhookListResponse represents a response from the webhook list endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/webhooks
+	// Docs:     https://docs.doppler.com/reference/webhooks-list
+	WebhookListResponse struct {
+		APIResponse `json:",inline"`
+		Webhooks    []*Webhook `json:"webhooks"`
+	}
+
+	// WebhookListOptions represents the options for the webhook list endpoint.
+	WebhookListOptions struct {
+		Project string `url:"project" json:"-" validate:"required"` // Identifier of the project.
+	}
+
+	// WebhookGetResponse represents a response from the webhook get endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/webhooks/webhook/{slug}
+	// Docs:     https://docs.doppler.com/reference/webhooks-retrieve
+	WebhookGetResponse struct {
+		APIResponse `json:",inline"`
+		Webhook     *Webhook `json:"webhook,omitempty"`
+	}
+
+	// WebhookGetOptions represents the options for the webhook get endpoint.
+	WebhookGetOptions struct {
+		Project string `url:"project" json:"-" validate:"required"` // Identifier of the project.
+		Slug    string `url:"-" json:"-" validate:"required"`       // Slug of the webhook.
+	}
+
+	// WebhookCreateResponse represents a response from the webhook create endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/webhooks
+	// Docs:     https://docs.doppler.com/reference/webhooks-add
+	WebhookCreateResponse struct {
+		APIResponse `json:",inline"`
+		Webhook     *Webhook `json:"webhook,omitempty"`
+	}
+
+	// WebhookCreateOptions represents the options for the webhook create endpoint.
+	WebhookCreateOptions struct {
+		Project        string   `url:"project" json:"-" validate:"required"`                                  // Identifier of the project.
+		URL            string   `url:"-" json:"url" validate:"required,url"`                                  // URL the webhook deliveries are sent to.
+		Name           *string  `url:"-" json:"name,omitempty"`                                               // Name of the webhook.
+		Secret         *string  `url:"-" json:"secret,omitempty"`                                             // Secret used to sign the deliveries.
+		EnabledConfigs []string `url:"-" json:"enabled_configs,omitempty" validate:"omitempty,dive,required"` // Names of the configs whose changes trigger the webhook.
+		Payload        *string  `url:"-" json:"payload,omitempty"`                                            // Custom payload template.
+	}
+
+	// WebhookUpdateResponse represents a response from the webhook update endpoint.
+	//
+	// Method:   PATCH
+	// Endpoint: https://api.doppler.com/v3/webhooks/webhook/{slug}
+	// Docs:     https://docs.doppler.com/reference/webhooks-update
+	WebhookUpdateResponse struct {
+		APIResponse `json:",inline"`
+		Webhook     *Webhook `json:"webhook,omitempty"`
+	}
+
+	// WebhookUpdateOptions represents the options for the webhook update endpoint.
+	WebhookUpdateOptions struct {
+		Project        string   `url:"project" json:"-" validate:"required"`                                                                                           // Identifier of the project.
+		Slug           string   `url:"-" json:"-" validate:"required"`                                                                                                 // Slug of the webhook.
+		NewURL         *string  `url:"-" json:"url,omitempty" validate:"required_without_all=NewName NewSecret NewPayload EnableConfigs DisableConfigs,omitempty,url"` // New URL the webhook deliveries are sent to.
+		NewName        *string  `url:"-" json:"name,omitempty"`                                                                                                        // New name of the webhook.
+		NewSecret      *string  `url:"-" json:"secret,omitempty"`                                                                                                      // New secret used to sign the deliveries.
+		NewPayload     *string  `url:"-" json:"payload,omitempty"`                                                                                                     // New custom payload template.
+		EnableConfigs  []string `url:"-" json:"enable_configs,omitempty" validate:"omitempty,dive,required"`                                                           // Names of the configs to add to the webhook's triggers.
+		DisableConfigs []string `url:"-" json:"disable_configs,omitempty" validate:"omitempty,dive,required"`                                                          // Names of the configs to remove from the webhook's triggers.
+	}
+
+	// WebhookDeleteResponse represents a response from the webhook delete endpoint.
+	//
+	// Method:   DELETE
+	// Endpoint: https://api.doppler.com/v3/webhooks/webhook/{slug}
+	// Docs:     https://docs.doppler.com/reference/webhooks-delete
+	WebhookDeleteResponse struct {
+		APIResponse `json:",inline"`
+	}
+
+	// WebhookDeleteOptions represents the options for the webhook delete endpoint.
+	WebhookDeleteOptions struct {
+		Project string `url:"project" json:"-" validate:"required"` // Identifier of the project.
+		Slug    string `url:"-" json:"-" validate:"required"`       // Slug of the webhook.
+	}
+
+	// WebhookEnableResponse represents a response from the webhook enable endpoint.
+	//
+	// Method:   PATCH
+	// Endpoint: https://api.doppler.com/v3/webhooks/webhook/{slug}/enable
+	// Docs:     https://docs.doppler.com/reference/webhooks-enable
+	WebhookEnableResponse struct {
+		APIResponse `json:",inline"`
+		Webhook     *Webhook `json:"webhook,omitempty"`
+	}
+
+	// WebhookEnableOptions represents the options for the webhook enable endpoint.
+	WebhookEnableOptions struct {
+		Project string `url:"project" json:"-" validate:"required"` // Identifier of the project.
+		Slug    string `url:"-" json:"-" validate:"required"`       // Slug of the webhook.
+	}
+
+	// WebhookDisableResponse represents a response from the webhook disable endpoint.
+	//
+	// Method:   PATCH
+	// Endpoint: https://api.doppler.com/v3/webhooks/webhook/{slug}/disable
+	// Docs:     https://docs.doppler.com/reference/webhooks-disable
+	WebhookDisableResponse struct {
+		APIResponse `json:",inline"`
+		Webhook     *Webhook `json:"webhook,omitempty"`
+	}
+
+	// WebhookDisableOptions represents the options for the webhook disable endpoint.
+	WebhookDisableOptions struct {
+		Project string `url:"project" json:"-" validate:"required"` // Identifier of the project.
+		Slug    string `url:"-" json:"-" validate:"required"`       // Slug of the webhook.
+	}
+)
@@ -0,0 +1,203 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/nikoksr/doppler-go"
+)
+
+// Client is the client used to invoke /v3/webhooks APIs.
+type Client struct {
+	Backend doppler.Backend
+	Key     string
+}
+
+// Default returns a new client based on the SDK's default backend and API key.
+func Default() *Client {
+	return &Client{
+		Backend: doppler.GetBackend(),
+		Key:     doppler.Key,
+	}
+}
+
+// webhookPath returns the path of the webhook with the given slug.
+func webhookPath(slug string) string {
+	return fmt.Sprintf("/v3/webhooks/webhook/%s", url.PathEscape(slug))
+}
+
+func (c Client) list(ctx context.Context, opts *doppler.WebhookListOptions) ([]*doppler.Webhook, doppler.APIResponse, error) {
+	var resp doppler.WebhookListResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    "/v3/webhooks",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Webhooks, resp.APIResponse, err
+}
+
+// List returns a list of webhooks of a project.
+func (c Client) List(ctx context.Context, opts *doppler.WebhookListOptions) ([]*doppler.Webhook, doppler.APIResponse, error) {
+	return c.list(ctx, opts)
+}
+
+// List returns a list of webhooks of a project using the default client.
+func List(ctx context.Context, opts *doppler.WebhookListOptions) ([]*doppler.Webhook, doppler.APIResponse, error) {
+	return Default().List(ctx, opts)
+}
+
+func (c Client) get(ctx context.Context, opts *doppler.WebhookGetOptions) (*doppler.Webhook, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.WebhookGetResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    webhookPath(opts.Slug),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Webhook, resp.APIResponse, err
+}
+
+// Get returns a webhook.
+func (c Client) Get(ctx context.Context, opts *doppler.WebhookGetOptions) (*doppler.Webhook, doppler.APIResponse, error) {
+	return c.get(ctx, opts)
+}
+
+// Get returns a webhook using the default client.
+func Get(ctx context.Context, opts *doppler.WebhookGetOptions) (*doppler.Webhook, doppler.APIResponse, error) {
+	return Default().Get(ctx, opts)
+}
+
+func (c Client) create(ctx context.Context, opts *doppler.WebhookCreateOptions) (*doppler.Webhook, doppler.APIResponse, error) {
+	var resp doppler.WebhookCreateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/webhooks",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Webhook, resp.APIResponse, err
+}
+
+// Create creates a new webhook for a project.
+func (c Client) Create(ctx context.Context, opts *doppler.WebhookCreateOptions) (*doppler.Webhook, doppler.APIResponse, error) {
+	return c.create(ctx, opts)
+}
+
+// Create creates a new webhook for a project using the default client.
+func Create(ctx context.Context, opts *doppler.WebhookCreateOptions) (*doppler.Webhook, doppler.APIResponse, error) {
+	return Default().Create(ctx, opts)
+}
+
+func (c Client) update(ctx context.Context, opts *doppler.WebhookUpdateOptions) (*doppler.Webhook, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.WebhookUpdateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPatch,
+		Path:    webhookPath(opts.Slug),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Webhook, resp.APIResponse, err
+}
+
+// Update updates a webhook.
+func (c Client) Update(ctx context.Context, opts *doppler.WebhookUpdateOptions) (*doppler.Webhook, doppler.APIResponse, error) {
+	return c.update(ctx, opts)
+}
+
+// Update updates a webhook using the default client.
+func Update(ctx context.Context, opts *doppler.WebhookUpdateOptions) (*doppler.Webhook, doppler.APIResponse, error) {
+	return Default().Update(ctx, opts)
+}
+
+func (c Client) delete(ctx context.Context, opts *doppler.WebhookDeleteOptions) (doppler.APIResponse, error) {
+	if opts == nil {
+		return doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.WebhookDeleteResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodDelete,
+		Path:    webhookPath(opts.Slug),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.APIResponse, err
+}
+
+// Delete deletes a webhook.
+func (c Client) Delete(ctx context.Context, opts *doppler.WebhookDeleteOptions) (doppler.APIResponse, error) {
+	return c.delete(ctx, opts)
+}
+
+// Delete deletes a webhook using the default client.
+func Delete(ctx context.Context, opts *doppler.WebhookDeleteOptions) (doppler.APIResponse, error) {
+	return Default().Delete(ctx, opts)
+}
+
+func (c Client) enable(ctx context.Context, opts *doppler.WebhookEnableOptions) (*doppler.Webhook, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.WebhookEnableResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPatch,
+		Path:    webhookPath(opts.Slug) + "/enable",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Webhook, resp.APIResponse, err
+}
+
+// Enable enables a webhook.
+func (c Client) Enable(ctx context.Context, opts *doppler.WebhookEnableOptions) (*doppler.Webhook, doppler.APIResponse, error) {
+	return c.enable(ctx, opts)
+}
+
+// Enable enables a webhook using the default client.
+func Enable(ctx context.Context, opts *doppler.WebhookEnableOptions) (*doppler.Webhook, doppler.APIResponse, error) {
+	return Default().Enable(ctx, opts)
+}
+
+func (c Client) disable(ctx context.Context, opts *doppler.WebhookDisableOptions) (*doppler.Webhook, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.WebhookDisableResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPatch,
+		Path:    webhookPath(opts.Slug) + "/disable",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Webhook, resp.APIResponse, err
+}
+
+// Disable disables a webhook. Disabled webhooks receive no deliveries.
+func (c Client) Disable(ctx context.Context, opts *doppler.WebhookDisableOptions) (*doppler.Webhook, doppler.APIResponse, error) {
+	return c.disable(ctx, opts)
+}
+
+// Disable disables a webhook. Disabled webhooks receive no deliveries using the default client.
+func Disable(ctx context.Context, opts *doppler.WebhookDisableOptions) (*doppler.Webhook, doppler.APIResponse, error) {
+	return Default().Disable(ctx, opts)
+}
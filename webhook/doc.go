@@ -0,0 +1,30 @@
+/*
+Package webhook provides a client for the Doppler API's webhook endpoints and an http.Handler to receive webhook
+deliveries.
+
+API-Docs: https://docs.doppler.com/reference/webhooks-list
+
+Example:
+
+	// Refresh the local secrets whenever the prd config changes
+	handler := webhook.NewHandler(os.Getenv("DOPPLER_WEBHOOK_SECRET"))
+	handler.On(doppler.WebhookEventConfigSecretsUpdate, func(ctx context.Context, event *doppler.WebhookEvent) error {
+		return refreshSecrets(ctx, *event.Config.Name)
+	})
+
+	// Webhooks whose payload template includes a unique ID and a send time can reject replays
+	_ = webhook.NewHandler(os.Getenv("DOPPLER_WEBHOOK_SECRET"), webhook.WithReplayProtection(5*time.Minute,
+		func(event *doppler.WebhookEvent) (string, time.Time, error) {
+			var payload struct {
+				ID     string    `json:"id"`
+				SentAt time.Time `json:"sent_at"`
+			}
+			err := json.Unmarshal(event.Raw, &payload)
+			return payload.ID, payload.SentAt, err
+		},
+	))
+
+	http.Handle("/webhooks/doppler", handler)
+	log.Fatal(http.ListenAndServe(":8080", nil))
+*/
+package webhook
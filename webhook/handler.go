@@ -0,0 +1,290 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/nikoksr/doppler-go"
+)
+
+const (
+	// SignatureHeader is the header Doppler sends the signature of a delivery in.
+	SignatureHeader = "X-Doppler-Signature"
+
+	// signaturePrefix is the prefix of the signature in the SignatureHeader.
+	signaturePrefix = "sha256="
+
+	// DefaultReplayWindow is the default maximum age of a delivery if replay protection is enabled.
+	DefaultReplayWindow = 5 * time.Minute
+
+	// DefaultMaxBodySize is the default maximum size of a delivery's body in bytes.
+	DefaultMaxBodySize int64 = 1 << 20
+)
+
+var (
+	// ErrInvalidSignature is returned by VerifySignature if the signature is missing or doesn't match the payload.
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+
+	// ErrReplay is reported to the error callback of a Handler if a delivery was already received.
+	ErrReplay = errors.New("webhook delivery was already received")
+
+	// ErrStaleDelivery is reported to the error callback of a Handler if a delivery's timestamp is outside the replay
+	// window.
+	ErrStaleDelivery = errors.New("webhook delivery is outside the replay window")
+)
+
+// Sign returns the signature of the given payload as sent by Doppler in the SignatureHeader.
+func Sign(payload []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks the given signature, as found in the SignatureHeader, against the payload and the webhook's
+// secret. It returns ErrInvalidSignature if they don't match.
+func VerifySignature(payload []byte, signature, secret string) error {
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return errors.Wrap(ErrInvalidSignature, "missing sha256 prefix")
+	}
+
+	// Compare the raw MACs in constant time.
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, signaturePrefix))
+	if err != nil {
+		return errors.Wrap(ErrInvalidSignature, "signature is not hex encoded")
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// EventHandlerFunc is a callback for webhook events. An error results in a 500 response, which makes Doppler retry
+// the delivery.
+type EventHandlerFunc func(ctx context.Context, event *doppler.WebhookEvent) error
+
+// HandlerOption is a function that configures a Handler.
+type HandlerOption func(*Handler)
+
+// DeliveryFunc returns the unique ID and the send time of a delivery. Both must come from the signed payload, e.g.
+// from fields of a custom payload template read from the event's Raw body; an error rejects the delivery.
+type DeliveryFunc func(event *doppler.WebhookEvent) (id string, sentAt time.Time, err error)
+
+// WithReplayProtection enables replay protection. Deliveries whose send time is further than the window away from
+// now are rejected with ErrStaleDelivery, and deliveries whose ID was already accepted within the window are rejected
+// with ErrReplay. A window of zero or less defaults to DefaultReplayWindow.
+func WithReplayProtection(window time.Duration, delivery DeliveryFunc) HandlerOption {
+	return func(h *Handler) {
+		if window <= 0 {
+			window = DefaultReplayWindow
+		}
+		h.replayWindow = window
+		h.delivery = delivery
+	}
+}
+
+// WithMaxBodySize sets the maximum size of a delivery's body in bytes. Defaults to DefaultMaxBodySize.
+func WithMaxBodySize(size int64) HandlerOption {
+	return func(h *Handler) {
+		h.maxBodySize = size
+	}
+}
+
+// WithErrorHandler sets a callback that's called for every rejected or failed delivery, e.g. for logging.
+func WithErrorHandler(fn func(r *http.Request, err error)) HandlerOption {
+	return func(h *Handler) {
+		h.onError = fn
+	}
+}
+
+// Handler is an http.Handler receiving Doppler webhook deliveries. It verifies the signature of every delivery, decodes
+// the payload and dispatches it to the callbacks registered for the event's type.
+//
+// Doppler's default payload carries neither a delivery ID nor a timestamp, and identical payloads are sent for
+// separate changes to the same config, so replays can't be told apart from legitimate deliveries by the payload alone.
+// Replay protection is therefore opt-in through WithReplayProtection, for webhooks whose payload template includes a
+// unique ID and a timestamp. Replays are rejected with 409 Conflict; deliveries whose callback failed aren't
+// remembered, so Doppler's retries go through.
+type Handler struct {
+	secret       string
+	replayWindow time.Duration
+	delivery     DeliveryFunc
+	maxBodySize  int64
+	onError      func(r *http.Request, err error)
+
+	mu       sync.Mutex
+	handlers map[doppler.WebhookEventType][]EventHandlerFunc
+	fallback []EventHandlerFunc
+	seen     map[string]time.Time // Accepted delivery IDs by the time they were accepted.
+	queue    []seenDelivery       // Accepted delivery IDs, oldest first.
+}
+
+// seenDelivery is an accepted delivery ID.
+type seenDelivery struct {
+	id string
+	at time.Time
+}
+
+// NewHandler returns a new Handler verifying deliveries with the given webhook secret. A validly signed delivery is
+// accepted however often it's received, unless replay protection is enabled through WithReplayProtection.
+func NewHandler(secret string, opts ...HandlerOption) *Handler {
+	h := &Handler{
+		secret:      secret,
+		maxBodySize: DefaultMaxBodySize,
+		handlers:    make(map[doppler.WebhookEventType][]EventHandlerFunc),
+		seen:        make(map[string]time.Time),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// On registers a callback for the given event type. Multiple callbacks are called in the order they were registered.
+func (h *Handler) On(eventType doppler.WebhookEventType, fn EventHandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.handlers[eventType] = append(h.handlers[eventType], fn)
+}
+
+// OnAny registers a callback for events without a callback of their own, e.g. deliveries with a custom payload.
+func (h *Handler) OnAny(fn EventHandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.fallback = append(h.fallback, fn)
+}
+
+// ServeHTTP handles a webhook delivery.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		h.reject(w, r, http.StatusMethodNotAllowed, errors.Errorf("method %s not allowed", r.Method))
+		return
+	}
+
+	// Read one byte more than allowed to detect oversized bodies.
+	body, err := io.ReadAll(io.LimitReader(r.Body, h.maxBodySize+1))
+	if err != nil {
+		h.reject(w, r, http.StatusBadRequest, errors.Wrap(err, "read body"))
+		return
+	}
+	if int64(len(body)) > h.maxBodySize {
+		h.reject(w, r, http.StatusRequestEntityTooLarge, errors.Errorf("body exceeds %d bytes", h.maxBodySize))
+		return
+	}
+
+	signature := r.Header.Get(SignatureHeader)
+	if err := VerifySignature(body, signature, h.secret); err != nil {
+		h.reject(w, r, http.StatusUnauthorized, err)
+		return
+	}
+
+	var event doppler.WebhookEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		h.reject(w, r, http.StatusBadRequest, errors.Wrap(err, "decode event"))
+		return
+	}
+	event.Raw = body
+
+	var id string
+	if h.delivery != nil {
+		var sentAt time.Time
+		id, sentAt, err = h.delivery(&event)
+		if err != nil {
+			h.reject(w, r, http.StatusBadRequest, errors.Wrap(err, "read delivery"))
+			return
+		}
+		if age := time.Since(sentAt); age > h.replayWindow || age < -h.replayWindow {
+			h.reject(w, r, http.StatusBadRequest, ErrStaleDelivery)
+			return
+		}
+		if !h.remember(id) {
+			h.reject(w, r, http.StatusConflict, ErrReplay)
+			return
+		}
+	}
+
+	for _, fn := range h.callbacks(event.Type) {
+		if err := fn(r.Context(), &event); err != nil {
+			// Forget the delivery, so that Doppler's retry isn't rejected as a replay.
+			if h.delivery != nil {
+				h.forget(id)
+			}
+			h.reject(w, r, http.StatusInternalServerError, errors.Wrapf(err, "handle %s event", event.Type))
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// callbacks returns the callbacks registered for the given event type, or the fallback callbacks if there are none.
+func (h *Handler) callbacks(eventType doppler.WebhookEventType) []EventHandlerFunc {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if fns := h.handlers[eventType]; len(fns) > 0 {
+		return fns
+	}
+
+	return h.fallback
+}
+
+// remember records the given delivery ID. It returns false if the ID was already accepted within the replay window.
+// Expired IDs are evicted from the front of the queue on the way.
+func (h *Handler) remember(id string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now()
+	for len(h.queue) > 0 && now.Sub(h.queue[0].at) >= h.replayWindow {
+		oldest := h.queue[0]
+		h.queue = h.queue[1:]
+		// The ID may have been forgotten and accepted again since it was queued.
+		if at, ok := h.seen[oldest.id]; ok && at.Equal(oldest.at) {
+			delete(h.seen, oldest.id)
+		}
+	}
+
+	if _, ok := h.seen[id]; ok {
+		return false
+	}
+	h.seen[id] = now
+	h.queue = append(h.queue, seenDelivery{id: id, at: now})
+
+	return true
+}
+
+// forget removes the given delivery ID from the accepted deliveries. Its queue entry is skipped on eviction.
+func (h *Handler) forget(id string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.seen, id)
+}
+
+// reject writes an error response and reports the error to the error callback, if any.
+func (h *Handler) reject(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if h.onError != nil {
+		h.onError(r, err)
+	}
+
+	http.Error(w, http.StatusText(status), status)
+}
@@ -0,0 +1,356 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/pointer"
+	"github.com/nikoksr/doppler-go/webhook"
+)
+
+const (
+	testSecret  = "secret"
+	testPayload = `{"type":"config.secrets.update","project":{"id":"backend","name":"backend"},"config":{"name":"prd"}}`
+)
+
+// deliver sends the given payload with the given signature to the handler and returns the response status.
+func deliver(h http.Handler, method, payload, signature string) int {
+	req := httptest.NewRequest(method, "/webhooks/doppler", strings.NewReader(payload))
+	if signature != "" {
+		req.Header.Set(webhook.SignatureHeader, signature)
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	return rec.Code
+}
+
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	payload := []byte(testPayload)
+
+	tests := []struct {
+		name      string
+		signature string
+		wantErr   bool
+	}{
+		{name: "Valid signature", signature: webhook.Sign(payload, testSecret), wantErr: false},
+		{name: "Wrong secret", signature: webhook.Sign(payload, "other"), wantErr: true},
+		{name: "Missing prefix", signature: strings.TrimPrefix(webhook.Sign(payload, testSecret), "sha256="), wantErr: true},
+		{name: "Not hex encoded", signature: "sha256=xyz", wantErr: true},
+		{name: "Empty signature", signature: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := webhook.VerifySignature(payload, tt.signature, testSecret)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unexpected error. Expected %t, got %v", tt.wantErr, err)
+			}
+			if err != nil && !errors.Is(err, webhook.ErrInvalidSignature) {
+				t.Errorf("Expected ErrInvalidSignature, got %v", err)
+			}
+		})
+	}
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		method     string
+		payload    string
+		signature  string
+		wantStatus int
+		wantEvent  bool
+	}{
+		{
+			name:       "Valid delivery",
+			method:     http.MethodPost,
+			payload:    testPayload,
+			signature:  webhook.Sign([]byte(testPayload), testSecret),
+			wantStatus: http.StatusNoContent,
+			wantEvent:  true,
+		},
+		{
+			name:       "Wrong method",
+			method:     http.MethodGet,
+			payload:    testPayload,
+			signature:  webhook.Sign([]byte(testPayload), testSecret),
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:       "Missing signature",
+			method:     http.MethodPost,
+			payload:    testPayload,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "Tampered payload",
+			method:     http.MethodPost,
+			payload:    strings.Replace(testPayload, "prd", "dev", 1),
+			signature:  webhook.Sign([]byte(testPayload), testSecret),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "Malformed payload",
+			method:     http.MethodPost,
+			payload:    "not json",
+			signature:  webhook.Sign([]byte("not json"), testSecret),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Oversized payload",
+			method:     http.MethodPost,
+			payload:    strings.Repeat("x", 1025),
+			signature:  webhook.Sign([]byte(strings.Repeat("x", 1025)), testSecret),
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotEvent *doppler.WebhookEvent
+			h := webhook.NewHandler(testSecret, webhook.WithMaxBodySize(1024))
+			h.On(doppler.WebhookEventConfigSecretsUpdate, func(_ context.Context, event *doppler.WebhookEvent) error {
+				gotEvent = event
+				return nil
+			})
+
+			if status := deliver(h, tt.method, tt.payload, tt.signature); status != tt.wantStatus {
+				t.Errorf("Unexpected status. Expected %d, got %d", tt.wantStatus, status)
+			}
+			if !tt.wantEvent {
+				if gotEvent != nil {
+					t.Errorf("Expected no event, got %+v", gotEvent)
+				}
+				return
+			}
+
+			wantEvent := &doppler.WebhookEvent{
+				Type:    doppler.WebhookEventConfigSecretsUpdate,
+				Project: &doppler.Project{ID: pointer.To("backend"), Name: pointer.To("backend")},
+				Config:  &doppler.Config{Name: pointer.To("prd")},
+				Raw:     []byte(testPayload),
+			}
+			if diff := cmp.Diff(wantEvent, gotEvent); diff != "" {
+				t.Errorf("Unexpected event (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHandler_IdenticalDeliveries(t *testing.T) {
+	t.Parallel()
+
+	// Separate changes to the same config produce identical deliveries, which must all reach the callback.
+	var calls int
+	h := webhook.NewHandler(testSecret)
+	h.OnAny(func(context.Context, *doppler.WebhookEvent) error {
+		calls++
+		return nil
+	})
+
+	signature := webhook.Sign([]byte(testPayload), testSecret)
+	for i := 0; i < 2; i++ {
+		if status := deliver(h, http.MethodPost, testPayload, signature); status != http.StatusNoContent {
+			t.Errorf("Unexpected status of delivery %d: %d", i+1, status)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got %d", calls)
+	}
+}
+
+// testDelivery reads the delivery ID and send time from a custom payload template.
+func testDelivery(event *doppler.WebhookEvent) (string, time.Time, error) {
+	var payload struct {
+		ID     string    `json:"id"`
+		SentAt time.Time `json:"sent_at"`
+	}
+	if err := json.Unmarshal(event.Raw, &payload); err != nil {
+		return "", time.Time{}, err
+	}
+	if payload.ID == "" {
+		return "", time.Time{}, errors.New("missing delivery ID")
+	}
+
+	return payload.ID, payload.SentAt, nil
+}
+
+// templatePayload returns a payload of the custom template read by testDelivery.
+func templatePayload(id string, sentAt time.Time) string {
+	return fmt.Sprintf(`{"type":"config.secrets.update","id":%q,"sent_at":%q}`, id, sentAt.Format(time.RFC3339Nano))
+}
+
+func TestHandler_ReplayProtection(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		payloads   []string
+		wantStatus []int
+		wantErr    error
+		wantCalls  int
+	}{
+		{
+			name:       "Distinct deliveries",
+			payloads:   []string{templatePayload("d1", now), templatePayload("d2", now)},
+			wantStatus: []int{http.StatusNoContent, http.StatusNoContent},
+			wantCalls:  2,
+		},
+		{
+			name:       "Replayed delivery",
+			payloads:   []string{templatePayload("d1", now), templatePayload("d1", now)},
+			wantStatus: []int{http.StatusNoContent, http.StatusConflict},
+			wantErr:    webhook.ErrReplay,
+			wantCalls:  1,
+		},
+		{
+			name:       "Stale delivery",
+			payloads:   []string{templatePayload("d1", now.Add(-time.Hour))},
+			wantStatus: []int{http.StatusBadRequest},
+			wantErr:    webhook.ErrStaleDelivery,
+		},
+		{
+			name:       "Delivery from the future",
+			payloads:   []string{templatePayload("d1", now.Add(time.Hour))},
+			wantStatus: []int{http.StatusBadRequest},
+			wantErr:    webhook.ErrStaleDelivery,
+		},
+		{
+			name:       "Delivery without ID",
+			payloads:   []string{testPayload},
+			wantStatus: []int{http.StatusBadRequest},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+			var gotErr error
+			h := webhook.NewHandler(testSecret,
+				webhook.WithReplayProtection(time.Minute, testDelivery),
+				webhook.WithErrorHandler(func(_ *http.Request, err error) { gotErr = err }),
+			)
+			h.OnAny(func(context.Context, *doppler.WebhookEvent) error {
+				calls++
+				return nil
+			})
+
+			for i, payload := range tt.payloads {
+				status := deliver(h, http.MethodPost, payload, webhook.Sign([]byte(payload), testSecret))
+				if status != tt.wantStatus[i] {
+					t.Errorf("Unexpected status of delivery %d. Expected %d, got %d", i+1, tt.wantStatus[i], status)
+				}
+			}
+			if tt.wantErr != nil && !errors.Is(gotErr, tt.wantErr) {
+				t.Errorf("Expected %v to be reported, got %v", tt.wantErr, gotErr)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("Expected %d calls, got %d", tt.wantCalls, calls)
+			}
+		})
+	}
+}
+
+func TestHandler_ReplayWindow(t *testing.T) {
+	t.Parallel()
+
+	// Deliveries are timestamped by the test, so that only the eviction of seen IDs is exercised.
+	h := webhook.NewHandler(testSecret, webhook.WithReplayProtection(50*time.Millisecond, func(*doppler.WebhookEvent) (string, time.Time, error) {
+		return "d1", time.Now(), nil
+	}))
+	h.OnAny(func(context.Context, *doppler.WebhookEvent) error { return nil })
+
+	signature := webhook.Sign([]byte(testPayload), testSecret)
+	if status := deliver(h, http.MethodPost, testPayload, signature); status != http.StatusNoContent {
+		t.Fatalf("Unexpected status of first delivery: %d", status)
+	}
+	if status := deliver(h, http.MethodPost, testPayload, signature); status != http.StatusConflict {
+		t.Errorf("Unexpected status of replayed delivery: %d", status)
+	}
+
+	// Once the replay window has passed, the ID is evicted.
+	time.Sleep(60 * time.Millisecond)
+	if status := deliver(h, http.MethodPost, testPayload, signature); status != http.StatusNoContent {
+		t.Errorf("Unexpected status of delivery after the replay window: %d", status)
+	}
+}
+
+func TestHandler_CallbackError(t *testing.T) {
+	t.Parallel()
+
+	fail := true
+	h := webhook.NewHandler(testSecret, webhook.WithReplayProtection(time.Minute, testDelivery))
+	h.On(doppler.WebhookEventConfigSecretsUpdate, func(context.Context, *doppler.WebhookEvent) error {
+		if fail {
+			return errors.New("refresh failed")
+		}
+		return nil
+	})
+
+	// A failed delivery isn't remembered, so that its retry is accepted.
+	payload := templatePayload("d1", time.Now())
+	signature := webhook.Sign([]byte(payload), testSecret)
+	if status := deliver(h, http.MethodPost, payload, signature); status != http.StatusInternalServerError {
+		t.Fatalf("Unexpected status of failed delivery: %d", status)
+	}
+
+	fail = false
+	if status := deliver(h, http.MethodPost, payload, signature); status != http.StatusNoContent {
+		t.Errorf("Unexpected status of retried delivery: %d", status)
+	}
+}
+
+func TestHandler_Dispatch(t *testing.T) {
+	t.Parallel()
+
+	var got []string
+	h := webhook.NewHandler(testSecret)
+	h.On(doppler.WebhookEventConfigSecretsUpdate, func(context.Context, *doppler.WebhookEvent) error {
+		got = append(got, "update-1")
+		return nil
+	})
+	h.On(doppler.WebhookEventConfigSecretsUpdate, func(context.Context, *doppler.WebhookEvent) error {
+		got = append(got, "update-2")
+		return nil
+	})
+	h.OnAny(func(_ context.Context, event *doppler.WebhookEvent) error {
+		got = append(got, "any:"+string(event.Type))
+		return nil
+	})
+
+	testEvent := `{"type":"webhook.test"}`
+	deliver(h, http.MethodPost, testPayload, webhook.Sign([]byte(testPayload), testSecret))
+	deliver(h, http.MethodPost, testEvent, webhook.Sign([]byte(testEvent), testSecret))
+
+	want := []string{"update-1", "update-2", "any:webhook.test"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected callbacks (-want +got):\n%s", diff)
+	}
+}
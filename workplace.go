@@ -30,7 +30,7 @@ type (
 
 	// WorkplaceUpdateOptions represents a request to the workplace update endpoint.
 	WorkplaceUpdateOptions struct {
-		NewName         *string `json:"name,omitempty"`          // New name of the workplace.
-		NewBillingEmail *string `json:"billing_email,omitempty"` // New billing email Doppler will send invoices to.
+		NewName         *string `json:"name,omitempty" validate:"omitempty,min=1"`          // New name of the workplace.
+		NewBillingEmail *string `json:"billing_email,omitempty" validate:"omitempty,email"` // New billing email Doppler will send invoices to.
 	}
 )
@@ -157,13 +157,8 @@ func TestClient_Update(t *testing.T) {
 				NewName: pointer.To(""),
 			},
 			wantWorkplace: nil,
-			wantResponse: doppler.APIResponse{
-				Success:    pointer.To(false),
-				Status:     "400 Bad Request",
-				StatusCode: http.StatusBadRequest,
-				Messages:   []string{"Name is empty"},
-			},
-			wantErr: true,
+			wantResponse:  doppler.APIResponse{}, // Name is empty; rejected by the options validation.
+			wantErr:       true,
 		},
 	}
 
@@ -0,0 +1,130 @@
+package doppler
+
+type (
+	// WorkplaceUser represents a user of a Doppler workplace.
+	WorkplaceUser struct {
+		ID            *string `json:"id,omitempty"`             // Unique identifier of the workplace user.
+		WorkplaceRole *Role   `json:"workplace_role,omitempty"` // The workplace role of the user.
+		CreatedAt     *string `json:"created_at,omitempty"`     // Date and time the user joined the workplace.
+		User          *User   `json:"user,omitempty"`           // The user's profile.
+	}
+
+	// WorkplaceInvite represents a pending invite to a Doppler workplace.
+	WorkplaceInvite struct {
+		Slug          *string `json:"slug,omitempty"`           // Unique identifier of the invite.
+		Email         *string `json:"email,omitempty"`          // Email address the invite was sent to.
+		WorkplaceRole *Role   `json:"workplace_role,omitempty"` // The workplace role the user gets when accepting the invite.
+		CreatedAt     *string `json:"created_at,omitempty"`     // Date and time the invite was created.
+	}
+
+	// WorkplaceUserListResponse represents a response from the workplace user list endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/workplace/users
+	// Docs:     https://docs.doppler.com/reference/users-list
+	WorkplaceUserListResponse struct {
+		APIResponse    `json:",inline"`
+		WorkplaceUsers []*WorkplaceUser `json:"workplace_users"`
+	}
+
+	// WorkplaceUserListOptions represents the options for the workplace user list endpoint.
+	WorkplaceUserListOptions struct {
+		ListOptions `url:",inline" json:"-"`
+		Email       *string `url:"email,omitempty" json:"-" validate:"omitempty,email"` // Only list the user with the given email address.
+	}
+
+	// WorkplaceUserGetResponse represents a response from the workplace user get endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/workplace/users/{id}
+	// Docs:     https://docs.doppler.com/reference/users-retrieve
+	WorkplaceUserGetResponse struct {
+		APIResponse   `json:",inline"`
+		WorkplaceUser *WorkplaceUser `json:"workplace_user,omitempty"`
+	}
+
+	// WorkplaceUserGetOptions represents the options for the workplace user get endpoint.
+	WorkplaceUserGetOptions struct {
+		ID string `url:"-" json:"-" validate:"required"` // Unique identifier of the workplace user.
+	}
+
+	// WorkplaceUserGetByEmailOptions represents the options for looking up a workplace user by email address.
+	WorkplaceUserGetByEmailOptions struct {
+		Email string `url:"email" json:"-" validate:"required,email"` // Email address of the user.
+	}
+
+	// WorkplaceUserUpdateResponse represents a response from the workplace user update endpoint.
+	//
+	// Method:   PATCH
+	// Endpoint: https://api.doppler.com/v3/workplace/users/{id}
+	// Docs:     https://docs.doppler.com/reference/users-update
+	WorkplaceUserUpdateResponse struct {
+		APIResponse   `json:",inline"`
+		WorkplaceUser *WorkplaceUser `json:"workplace_user,omitempty"`
+	}
+
+	// WorkplaceUserUpdateOptions represents the options for the workplace user update endpoint.
+	WorkplaceUserUpdateOptions struct {
+		ID               string `url:"-" json:"-" validate:"required"`              // Unique identifier of the workplace user.
+		NewWorkplaceRole string `url:"-" json:"workplace_role" validate:"required"` // Identifier of the new workplace role.
+	}
+
+	// WorkplaceUserRemoveResponse represents a response from the workplace user remove endpoint.
+	//
+	// Method:   DELETE
+	// Endpoint: https://api.doppler.com/v3/workplace/users/{id}
+	// Docs:     https://docs.doppler.com/reference/users-delete
+	WorkplaceUserRemoveResponse struct {
+		APIResponse `json:",inline"`
+	}
+
+	// WorkplaceUserRemoveOptions represents the options for the workplace user remove endpoint.
+	WorkplaceUserRemoveOptions struct {
+		ID string `url:"-" json:"-" validate:"required"` // Unique identifier of the workplace user.
+	}
+
+	// WorkplaceInviteListResponse represents a response from the workplace invite list endpoint.
+	//
+	// Method:   GET
+	// Endpoint: https://api.doppler.com/v3/workplace/invites
+	// Docs:     https://docs.doppler.com/reference/invites-list
+	WorkplaceInviteListResponse struct {
+		APIResponse `json:",inline"`
+		Invites     []*WorkplaceInvite `json:"invites"`
+	}
+
+	// WorkplaceInviteListOptions represents the options for the workplace invite list endpoint.
+	WorkplaceInviteListOptions struct {
+		ListOptions `url:",inline" json:"-"`
+	}
+
+	// WorkplaceInviteCreateResponse represents a response from the workplace invite create endpoint.
+	//
+	// Method:   POST
+	// Endpoint: https://api.doppler.com/v3/workplace/invites
+	// Docs:     https://docs.doppler.com/reference/invites-create
+	WorkplaceInviteCreateResponse struct {
+		APIResponse `json:",inline"`
+		Invite      *WorkplaceInvite `json:"invite,omitempty"`
+	}
+
+	// WorkplaceInviteCreateOptions represents the options for the workplace invite create endpoint.
+	WorkplaceInviteCreateOptions struct {
+		Email         string  `url:"-" json:"email" validate:"required,email"` // Email address to send the invite to.
+		WorkplaceRole *string `url:"-" json:"workplace_role,omitempty"`        // Identifier of the workplace role. Defaults to the workplace's default role.
+	}
+
+	// WorkplaceInviteRevokeResponse represents a response from the workplace invite revoke endpoint.
+	//
+	// Method:   DELETE
+	// Endpoint: https://api.doppler.com/v3/workplace/invites/invite/{slug}
+	// Docs:     https://docs.doppler.com/reference/invites-delete
+	WorkplaceInviteRevokeResponse struct {
+		APIResponse `json:",inline"`
+	}
+
+	// WorkplaceInviteRevokeOptions represents the options for the workplace invite revoke endpoint.
+	WorkplaceInviteRevokeOptions struct {
+		Slug string `url:"-" json:"-" validate:"required"` // Unique identifier of the invite.
+	}
+)
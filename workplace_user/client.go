@@ -0,0 +1,242 @@
+package workplaceuser
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/nikoksr/doppler-go"
+)
+
+// ErrUserNotFound is returned by GetByEmail if no workplace user has the given email address.
+var ErrUserNotFound = errors.New("workplace user not found")
+
+// Client is the client used to invoke /v3/workplace/users and /v3/workplace/invites APIs.
+type Client struct {
+	Backend doppler.Backend
+	Key     string
+}
+
+// Default returns a new client based on the SDK's default backend and API key.
+func Default() *Client {
+	return &Client{
+		Backend: doppler.GetBackend(),
+		Key:     doppler.Key,
+	}
+}
+
+// userPath returns the path of the workplace user with the given ID.
+func userPath(id string) string {
+	return fmt.Sprintf("/v3/workplace/users/%s", url.PathEscape(id))
+}
+
+func (c Client) list(ctx context.Context, opts *doppler.WorkplaceUserListOptions) ([]*doppler.WorkplaceUser, doppler.APIResponse, error) {
+	var resp doppler.WorkplaceUserListResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    "/v3/workplace/users",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.WorkplaceUsers, resp.APIResponse, err
+}
+
+// List returns a page of workplace users.
+func (c Client) List(ctx context.Context, opts *doppler.WorkplaceUserListOptions) ([]*doppler.WorkplaceUser, doppler.APIResponse, error) {
+	return c.list(ctx, opts)
+}
+
+// List returns a page of workplace users using the default client.
+func List(ctx context.Context, opts *doppler.WorkplaceUserListOptions) ([]*doppler.WorkplaceUser, doppler.APIResponse, error) {
+	return Default().List(ctx, opts)
+}
+
+func (c Client) get(ctx context.Context, opts *doppler.WorkplaceUserGetOptions) (*doppler.WorkplaceUser, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.WorkplaceUserGetResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    userPath(opts.ID),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.WorkplaceUser, resp.APIResponse, err
+}
+
+// Get returns a workplace user by ID.
+func (c Client) Get(ctx context.Context, opts *doppler.WorkplaceUserGetOptions) (*doppler.WorkplaceUser, doppler.APIResponse, error) {
+	return c.get(ctx, opts)
+}
+
+// Get returns a workplace user by ID using the default client.
+func Get(ctx context.Context, opts *doppler.WorkplaceUserGetOptions) (*doppler.WorkplaceUser, doppler.APIResponse, error) {
+	return Default().Get(ctx, opts)
+}
+
+func (c Client) getByEmail(ctx context.Context, opts *doppler.WorkplaceUserGetByEmailOptions) (*doppler.WorkplaceUser, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.WorkplaceUserListResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    "/v3/workplace/users",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+	if err != nil {
+		return nil, resp.APIResponse, err
+	}
+
+	// The email filter is applied by the API already; matching again guards against partial matches.
+	for _, user := range resp.WorkplaceUsers {
+		if user.User != nil && user.User.Email != nil && strings.EqualFold(*user.User.Email, opts.Email) {
+			return user, resp.APIResponse, nil
+		}
+	}
+
+	return nil, resp.APIResponse, errors.Wrapf(ErrUserNotFound, "no user with email %q", opts.Email)
+}
+
+// GetByEmail returns the workplace user with the given email address. It returns ErrUserNotFound if there's no such
+// user.
+func (c Client) GetByEmail(ctx context.Context, opts *doppler.WorkplaceUserGetByEmailOptions) (*doppler.WorkplaceUser, doppler.APIResponse, error) {
+	return c.getByEmail(ctx, opts)
+}
+
+// GetByEmail returns the workplace user with the given email address using the default client.
+func GetByEmail(ctx context.Context, opts *doppler.WorkplaceUserGetByEmailOptions) (*doppler.WorkplaceUser, doppler.APIResponse, error) {
+	return Default().GetByEmail(ctx, opts)
+}
+
+func (c Client) update(ctx context.Context, opts *doppler.WorkplaceUserUpdateOptions) (*doppler.WorkplaceUser, doppler.APIResponse, error) {
+	if opts == nil {
+		return nil, doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.WorkplaceUserUpdateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPatch,
+		Path:    userPath(opts.ID),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.WorkplaceUser, resp.APIResponse, err
+}
+
+// Update changes the workplace role of a workplace user.
+func (c Client) Update(ctx context.Context, opts *doppler.WorkplaceUserUpdateOptions) (*doppler.WorkplaceUser, doppler.APIResponse, error) {
+	return c.update(ctx, opts)
+}
+
+// Update changes the workplace role of a workplace user using the default client.
+func Update(ctx context.Context, opts *doppler.WorkplaceUserUpdateOptions) (*doppler.WorkplaceUser, doppler.APIResponse, error) {
+	return Default().Update(ctx, opts)
+}
+
+func (c Client) remove(ctx context.Context, opts *doppler.WorkplaceUserRemoveOptions) (doppler.APIResponse, error) {
+	if opts == nil {
+		return doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.WorkplaceUserRemoveResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodDelete,
+		Path:    userPath(opts.ID),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.APIResponse, err
+}
+
+// Remove removes a user from the workplace.
+func (c Client) Remove(ctx context.Context, opts *doppler.WorkplaceUserRemoveOptions) (doppler.APIResponse, error) {
+	return c.remove(ctx, opts)
+}
+
+// Remove removes a user from the workplace using the default client.
+func Remove(ctx context.Context, opts *doppler.WorkplaceUserRemoveOptions) (doppler.APIResponse, error) {
+	return Default().Remove(ctx, opts)
+}
+
+func (c Client) inviteList(ctx context.Context, opts *doppler.WorkplaceInviteListOptions) ([]*doppler.WorkplaceInvite, doppler.APIResponse, error) {
+	var resp doppler.WorkplaceInviteListResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodGet,
+		Path:    "/v3/workplace/invites",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Invites, resp.APIResponse, err
+}
+
+// InviteList returns a page of pending workplace invites.
+func (c Client) InviteList(ctx context.Context, opts *doppler.WorkplaceInviteListOptions) ([]*doppler.WorkplaceInvite, doppler.APIResponse, error) {
+	return c.inviteList(ctx, opts)
+}
+
+// InviteList returns a page of pending workplace invites using the default client.
+func InviteList(ctx context.Context, opts *doppler.WorkplaceInviteListOptions) ([]*doppler.WorkplaceInvite, doppler.APIResponse, error) {
+	return Default().InviteList(ctx, opts)
+}
+
+func (c Client) inviteCreate(ctx context.Context, opts *doppler.WorkplaceInviteCreateOptions) (*doppler.WorkplaceInvite, doppler.APIResponse, error) {
+	var resp doppler.WorkplaceInviteCreateResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodPost,
+		Path:    "/v3/workplace/invites",
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.Invite, resp.APIResponse, err
+}
+
+// InviteCreate invites a user to the workplace by email.
+func (c Client) InviteCreate(ctx context.Context, opts *doppler.WorkplaceInviteCreateOptions) (*doppler.WorkplaceInvite, doppler.APIResponse, error) {
+	return c.inviteCreate(ctx, opts)
+}
+
+// InviteCreate invites a user to the workplace by email using the default client.
+func InviteCreate(ctx context.Context, opts *doppler.WorkplaceInviteCreateOptions) (*doppler.WorkplaceInvite, doppler.APIResponse, error) {
+	return Default().InviteCreate(ctx, opts)
+}
+
+func (c Client) inviteRevoke(ctx context.Context, opts *doppler.WorkplaceInviteRevokeOptions) (doppler.APIResponse, error) {
+	if opts == nil {
+		return doppler.APIResponse{}, fmt.Errorf("options may not be nil")
+	}
+
+	var resp doppler.WorkplaceInviteRevokeResponse
+	err := c.Backend.Call(ctx, &doppler.Request{
+		Method:  http.MethodDelete,
+		Path:    "/v3/workplace/invites/invite/" + url.PathEscape(opts.Slug),
+		Key:     c.Key,
+		Payload: opts,
+	}, &resp)
+
+	return resp.APIResponse, err
+}
+
+// InviteRevoke revokes a pending workplace invite.
+func (c Client) InviteRevoke(ctx context.Context, opts *doppler.WorkplaceInviteRevokeOptions) (doppler.APIResponse, error) {
+	return c.inviteRevoke(ctx, opts)
+}
+
+// InviteRevoke revokes a pending workplace invite using the default client.
+func InviteRevoke(ctx context.Context, opts *doppler.WorkplaceInviteRevokeOptions) (doppler.APIResponse, error) {
+	return Default().InviteRevoke(ctx, opts)
+}
@@ -0,0 +1,807 @@
+package workplaceuser_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/nikoksr/doppler-go"
+	"github.com/nikoksr/doppler-go/pointer"
+	workplaceuser "github.com/nikoksr/doppler-go/workplace_user"
+)
+
+func TestDefault(t *testing.T) {
+	t.Parallel()
+
+	client := workplaceuser.Default()
+	if client == nil {
+		t.Fatal("Expected client to be set")
+	}
+	if client.Backend == nil {
+		t.Fatal("Expected client backend to be set")
+	}
+	if client.Key != doppler.Key {
+		t.Fatalf("Expected client key to be %q, got %q", doppler.Key, client.Key)
+	}
+}
+
+func TestWorkplaceUser_List(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.WorkplaceUserListOptions
+		wantUsers    []*doppler.WorkplaceUser
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "List users",
+			options: &doppler.WorkplaceUserListOptions{ListOptions: doppler.ListOptions{Page: 2}},
+			wantUsers: []*doppler.WorkplaceUser{
+				{
+					ID:            pointer.To("123"),
+					WorkplaceRole: &doppler.Role{Identifier: pointer.To("collaborator"), Name: pointer.To("Collaborator")},
+					CreatedAt:     pointer.To("2021-01-01T00:00:00.000Z"),
+					User: &doppler.User{
+						Email:    pointer.To("jane@example.com"),
+						Name:     pointer.To("Jane"),
+						UserName: pointer.To("jane"),
+					},
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:         "List users with invalid email",
+			options:      &doppler.WorkplaceUserListOptions{Email: pointer.To("jane")},
+			wantUsers:    nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+		{
+			name:      "List users with error",
+			options:   nil,
+			wantUsers: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "403 Forbidden",
+				StatusCode: http.StatusForbidden,
+				Messages:   []string{"Missing permission"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/workplace/users" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.WorkplaceUserListResponse{
+					WorkplaceUsers: tt.wantUsers,
+					APIResponse:    tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &workplaceuser.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotUsers, gotResponse, err := client.List(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantUsers, gotUsers); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkplaceUser_Get(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.WorkplaceUserGetOptions
+		wantUser     *doppler.WorkplaceUser
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "Get user",
+			options: &doppler.WorkplaceUserGetOptions{ID: "123"},
+			wantUser: &doppler.WorkplaceUser{
+				ID:            pointer.To("123"),
+				WorkplaceRole: &doppler.Role{Identifier: pointer.To("collaborator"), Name: pointer.To("Collaborator")},
+				CreatedAt:     pointer.To("2021-01-01T00:00:00.000Z"),
+				User: &doppler.User{
+					Email:    pointer.To("jane@example.com"),
+					Name:     pointer.To("Jane"),
+					UserName: pointer.To("jane"),
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Get user with error",
+			options:  &doppler.WorkplaceUserGetOptions{ID: "123"},
+			wantUser: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"User not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Get user without options",
+			options:      nil,
+			wantUser:     nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/workplace/users/123" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.WorkplaceUserGetResponse{
+					WorkplaceUser: tt.wantUser,
+					APIResponse:   tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &workplaceuser.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotUser, gotResponse, err := client.Get(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantUser, gotUser); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkplaceUser_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.WorkplaceUserUpdateOptions
+		wantBody     map[string]any
+		wantUser     *doppler.WorkplaceUser
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:     "Update workplace role",
+			options:  &doppler.WorkplaceUserUpdateOptions{ID: "123", NewWorkplaceRole: "collaborator"},
+			wantBody: map[string]any{"workplace_role": "collaborator"},
+			wantUser: &doppler.WorkplaceUser{
+				ID:            pointer.To("123"),
+				WorkplaceRole: &doppler.Role{Identifier: pointer.To("collaborator"), Name: pointer.To("Collaborator")},
+				CreatedAt:     pointer.To("2021-01-01T00:00:00.000Z"),
+				User: &doppler.User{
+					Email:    pointer.To("jane@example.com"),
+					Name:     pointer.To("Jane"),
+					UserName: pointer.To("jane"),
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Update workplace role with error",
+			options:  &doppler.WorkplaceUserUpdateOptions{ID: "123", NewWorkplaceRole: "unknown"},
+			wantBody: map[string]any{"workplace_role": "unknown"},
+			wantUser: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"Invalid role"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Update without role",
+			options:      &doppler.WorkplaceUserUpdateOptions{ID: "123"},
+			wantUser:     nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+		{
+			name:         "Update without options",
+			options:      nil,
+			wantUser:     nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch || r.URL.Path != "/v3/workplace/users/123" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.WorkplaceUserUpdateResponse{
+					WorkplaceUser: tt.wantUser,
+					APIResponse:   tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &workplaceuser.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotUser, gotResponse, err := client.Update(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantUser, gotUser); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkplaceUser_Remove(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.WorkplaceUserRemoveOptions
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "Remove user",
+			options: &doppler.WorkplaceUserRemoveOptions{ID: "123"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Remove user with error",
+			options: &doppler.WorkplaceUserRemoveOptions{ID: "123"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Messages:   []string{"The last owner can't be removed"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Remove user without options",
+			options:      nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/v3/workplace/users/123" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.WorkplaceUserRemoveResponse{
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &workplaceuser.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotResponse, err := client.Remove(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkplaceUser_InviteList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.WorkplaceInviteListOptions
+		wantInvites  []*doppler.WorkplaceInvite
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "List invites",
+			options: &doppler.WorkplaceInviteListOptions{},
+			wantInvites: []*doppler.WorkplaceInvite{
+				{
+					Slug:          pointer.To("abc"),
+					Email:         pointer.To("john@example.com"),
+					WorkplaceRole: &doppler.Role{Identifier: pointer.To("viewer")},
+					CreatedAt:     pointer.To("2021-01-01T00:00:00.000Z"),
+				},
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:        "List invites with error",
+			options:     nil,
+			wantInvites: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "403 Forbidden",
+				StatusCode: http.StatusForbidden,
+				Messages:   []string{"Missing permission"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/v3/workplace/invites" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.WorkplaceInviteListResponse{
+					Invites:     tt.wantInvites,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &workplaceuser.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotInvites, gotResponse, err := client.InviteList(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantInvites, gotInvites); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkplaceUser_InviteCreate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.WorkplaceInviteCreateOptions
+		wantBody     map[string]any
+		wantInvite   *doppler.WorkplaceInvite
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:     "Create invite",
+			options:  &doppler.WorkplaceInviteCreateOptions{Email: "john@example.com", WorkplaceRole: pointer.To("viewer")},
+			wantBody: map[string]any{"email": "john@example.com", "workplace_role": "viewer"},
+			wantInvite: &doppler.WorkplaceInvite{
+				Slug:          pointer.To("abc"),
+				Email:         pointer.To("john@example.com"),
+				WorkplaceRole: &doppler.Role{Identifier: pointer.To("viewer")},
+				CreatedAt:     pointer.To("2021-01-01T00:00:00.000Z"),
+			},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:       "Create invite with error",
+			options:    &doppler.WorkplaceInviteCreateOptions{Email: "jane@example.com"},
+			wantBody:   map[string]any{"email": "jane@example.com"},
+			wantInvite: nil,
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "409 Conflict",
+				StatusCode: http.StatusConflict,
+				Messages:   []string{"User is already a member of the workplace"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Create invite with invalid email",
+			options:      &doppler.WorkplaceInviteCreateOptions{Email: "john"},
+			wantInvite:   nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v3/workplace/invites" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Check if the body is expected.
+				var gotBody map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+				if diff := cmp.Diff(tt.wantBody, gotBody); diff != "" {
+					t.Errorf("Unexpected body (-want +got):\n%s", diff)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.WorkplaceInviteCreateResponse{
+					Invite:      tt.wantInvite,
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &workplaceuser.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotInvite, gotResponse, err := client.InviteCreate(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the result is expected.
+			if diff := cmp.Diff(tt.wantInvite, gotInvite); diff != "" {
+				t.Errorf("Unexpected result (-want +got):\n%s", diff)
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkplaceUser_InviteRevoke(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		options      *doppler.WorkplaceInviteRevokeOptions
+		wantResponse doppler.APIResponse
+		wantErr      bool
+	}{
+		{
+			name:    "Revoke invite",
+			options: &doppler.WorkplaceInviteRevokeOptions{Slug: "abc"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(true),
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Revoke invite with error",
+			options: &doppler.WorkplaceInviteRevokeOptions{Slug: "abc"},
+			wantResponse: doppler.APIResponse{
+				Success:    pointer.To(false),
+				Status:     "404 Not Found",
+				StatusCode: http.StatusNotFound,
+				Messages:   []string{"Invite not found"},
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Revoke invite without options",
+			options:      nil,
+			wantResponse: doppler.APIResponse{},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/v3/workplace/invites/invite/abc" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+
+				// Write the expected response to the ResponseWriter.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.wantResponse.StatusCode)
+				err := json.NewEncoder(w).Encode(&doppler.WorkplaceInviteRevokeResponse{
+					APIResponse: tt.wantResponse,
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &workplaceuser.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotResponse, err := client.InviteRevoke(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			// Check if the API response is expected. Ignore the http.Header field, since it's variable.
+			if diff := cmp.Diff(tt.wantResponse, gotResponse, cmpopts.IgnoreFields(doppler.APIResponse{}, "Header")); diff != "" {
+				t.Errorf("Unexpected API response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkplaceUser_GetByEmail(t *testing.T) {
+	t.Parallel()
+
+	users := []*doppler.WorkplaceUser{
+		{ID: pointer.To("123"), User: &doppler.User{Email: pointer.To("Jane@example.com")}},
+		{ID: pointer.To("456"), User: &doppler.User{Email: pointer.To("john@example.com")}},
+	}
+
+	tests := []struct {
+		name         string
+		options      *doppler.WorkplaceUserGetByEmailOptions
+		wantUser     *doppler.WorkplaceUser
+		wantNotFound bool
+		wantErr      bool
+	}{
+		{
+			name:     "Get user by email",
+			options:  &doppler.WorkplaceUserGetByEmailOptions{Email: "jane@example.com"},
+			wantUser: users[0],
+			wantErr:  false,
+		},
+		{
+			name:         "Get unknown user by email",
+			options:      &doppler.WorkplaceUserGetByEmailOptions{Email: "jim@example.com"},
+			wantUser:     nil,
+			wantNotFound: true,
+			wantErr:      true,
+		},
+		{
+			name:     "Get user by invalid email",
+			options:  &doppler.WorkplaceUserGetByEmailOptions{Email: "jane"},
+			wantUser: nil,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Create a new httptest.Server that will be used to mock the Doppler API.
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.URL.Query().Get("email"); got != tt.options.Email {
+					t.Errorf("Unexpected email query parameter: %q", got)
+				}
+
+				// Write all users; the client has to pick the right one.
+				w.Header().Set("Content-Type", "application/json")
+				err := json.NewEncoder(w).Encode(&doppler.WorkplaceUserListResponse{
+					WorkplaceUsers: users,
+					APIResponse:    doppler.APIResponse{Success: pointer.To(true)},
+				})
+				if err != nil {
+					t.Fatalf("Failed to write response: %v", err)
+				}
+			}))
+			defer ts.Close()
+
+			// Create a new Doppler client with the httptest.Server URL as base URL.
+			client := &workplaceuser.Client{
+				Backend: doppler.GetBackendWithConfig(&doppler.BackendConfig{
+					URL: pointer.To(ts.URL),
+				}),
+				Key: "test",
+			}
+
+			gotUser, _, err := client.GetByEmail(context.Background(), tt.options)
+			// Check if the error is expected.
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unexpected error. Expected %t, got %t", tt.wantErr, err != nil)
+				return
+			}
+			if gotNotFound := errors.Is(err, workplaceuser.ErrUserNotFound); gotNotFound != tt.wantNotFound {
+				t.Errorf("Unexpected not found error. Expected %t, got %t", tt.wantNotFound, gotNotFound)
+			}
+			// Check if the user is expected.
+			if diff := cmp.Diff(tt.wantUser, gotUser); diff != "" {
+				t.Errorf("Unexpected user (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+/*
+Package workplaceuser provides a client for the Doppler API's workplace user and invite endpoints.
+
+API-Docs: https://docs.doppler.com/reference/users-list
+
+Example:
+
+	// Invite a new colleague, unless they're already part of the workplace
+	_, _, err := workplaceuser.GetByEmail(context.Background(), &doppler.WorkplaceUserGetByEmailOptions{
+		Email: "jane@example.com",
+	})
+	if errors.Is(err, workplaceuser.ErrUserNotFound) {
+		_, _, err = workplaceuser.InviteCreate(context.Background(), &doppler.WorkplaceInviteCreateOptions{
+			Email: "jane@example.com",
+		})
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+*/
+package workplaceuser